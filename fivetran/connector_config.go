@@ -0,0 +1,203 @@
+package fivetran
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The config block schemas, the expand/flatten functions and the connectorConfigServices catalog are generated
+// from the connector config metadata snapshot, see tools/genconfig.
+//go:generate go run ../tools/genconfig -metadata metadata/connector_config.json -output connector_config_gen.go
+
+// connectorConfigUpstream returns the full upstream connector config, DoCustomMerged moves the known
+// config fields from the custom config to the typed config
+func connectorConfigUpstream(resp *fivetran.ConnectorCustomMergedDetailsResponse) map[string]interface{} {
+	upstream := make(map[string]interface{})
+	for k, v := range resp.Data.CustomConfig {
+		upstream[k] = v
+	}
+	if err := fivetran.MergeIntoMap(resp.Data.Config, &upstream); err != nil {
+		return resp.Data.CustomConfig
+	}
+	return upstream
+}
+
+// connectorConfigValueStr returns the string representation of an upstream scalar config value
+func connectorConfigValueStr(v interface{}) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case bool:
+		return boolToStr(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int:
+		return intToStr(t)
+	}
+	return ""
+}
+
+// connectorConfigValueBool returns an upstream boolean config value, ok is false if the value isn't a boolean
+func connectorConfigValueBool(v interface{}) (value bool, ok bool) {
+	switch t := v.(type) {
+	case bool:
+		return t, true
+	case string:
+		if b, err := strconv.ParseBool(t); err == nil {
+			return b, true
+		}
+	}
+	return false, false
+}
+
+// connectorConfigValueInt returns an upstream integer config value, ok is false if the value isn't an integer
+func connectorConfigValueInt(v interface{}) (value int, ok bool) {
+	switch t := v.(type) {
+	case float64:
+		return int(t), true
+	case int:
+		return t, true
+	case string:
+		if i, err := strconv.Atoi(t); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// connectorConfigRawBlock returns the config block of the raw config list, or a null value if the block isn't set
+func connectorConfigRawBlock(config cty.Value) cty.Value {
+	if !config.IsKnown() || config.IsNull() || config.LengthInt() == 0 {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return config.Index(cty.NumberIntVal(0))
+}
+
+// connectorConfigRawIsSet reports whether the field k is set in the raw config block, the zero values of the
+// boolean and integer fields can't be told apart from the unset fields in the ResourceData
+func connectorConfigRawIsSet(raw cty.Value, k string) bool {
+	if !raw.IsKnown() || raw.IsNull() || !raw.Type().IsObjectType() || !raw.Type().HasAttribute(k) {
+		return false
+	}
+	return !raw.GetAttr(k).IsNull()
+}
+
+// connectorConfigValueList returns an upstream list config value with the elements as strings
+func connectorConfigValueList(v interface{}) []interface{} {
+	xi, _ := v.([]interface{})
+	result := make([]interface{}, 0, len(xi))
+	for _, e := range xi {
+		if s := connectorConfigValueStr(e); s != "" {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// getSubcollectionElementStr returns the targetKey value of the configKey collection element with the
+// subKey value equal to subKeyValue in the currentConfig, or "" if there is no such element
+func getSubcollectionElementStr(configKey, subKey, subKeyValue, targetKey string, currentConfig []interface{}) string {
+	var targetList []interface{}
+	switch v := currentConfig[0].(map[string]interface{})[configKey].(type) {
+	case *schema.Set:
+		targetList = v.List()
+	case []interface{}:
+		targetList = v
+	}
+	for _, v := range targetList {
+		if v.(map[string]interface{})[subKey].(string) == subKeyValue {
+			if result, ok := v.(map[string]interface{})[targetKey].(string); ok {
+				return result
+			}
+		}
+	}
+	return ""
+}
+
+// connectorConfigServiceField is a config field of a service in the connectorConfigServices catalog
+type connectorConfigServiceField struct {
+	// ReadOnly fields are set by Fivetran for the service
+	ReadOnly bool
+	// Enum is the list of the allowed values, any value is allowed when it is empty
+	Enum []string
+}
+
+// connectorConfigValidate checks the config block fields set in the configuration against the
+// connectorConfigServices catalog of the service. Invalid enum values are errors, the fields set by Fivetran and
+// the fields the service doesn't use are warnings. The services missing in the catalog aren't validated.
+func connectorConfigValidate(service string, config cty.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fields, ok := connectorConfigServices[service]
+	block := connectorConfigRawBlock(config)
+	if !ok || !block.IsKnown() || block.IsNull() {
+		return diags
+	}
+
+	values := block.AsValueMap()
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		v := values[k]
+		// the nested blocks are empty collections when they aren't set
+		if v.IsNull() || (v.IsKnown() && (v.Type().IsSetType() || v.Type().IsListType()) && v.LengthInt() == 0) {
+			continue
+		}
+
+		var d diag.Diagnostic
+		field, ok := fields[k]
+		switch {
+		case !ok:
+			d = newDiag(diag.Warning, "ignored config field",
+				fmt.Sprintf("config.0.%v: service %v doesn't use this field, it is ignored", k, service))
+		case field.ReadOnly:
+			d = newDiag(diag.Warning, "read only config field",
+				fmt.Sprintf("config.0.%v: the field is set by Fivetran for service %v, the configured value may be ignored", k, service))
+		case len(field.Enum) > 0 && v.IsKnown() && v.Type() == cty.String && !connectorConfigEnumContains(field.Enum, v.AsString()):
+			d = newDiag(diag.Error, "invalid config value",
+				fmt.Sprintf("config.0.%v: expected one of [%v] for service %v, got: %q", k, strings.Join(field.Enum, ", "), service, v.AsString()))
+		default:
+			continue
+		}
+		d.AttributePath = cty.GetAttrPath("config").IndexInt(0).GetAttr(k)
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
+func connectorConfigEnumContains(enum []string, value string) bool {
+	for _, v := range enum {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// connectorConfigValidateError returns the connectorConfigValidate errors as a single error. CustomizeDiff can't
+// report warnings, they are reported by the create and update of the connector.
+func connectorConfigValidateError(diags diag.Diagnostics) error {
+	var details []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			details = append(details, d.Detail)
+		}
+	}
+	if len(details) == 0 {
+		return nil
+	}
+	return errors.New(strings.Join(details, "; "))
+}
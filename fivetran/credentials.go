@@ -0,0 +1,178 @@
+package fivetran
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	defaultCredentialsFile = "~/.fivetran/credentials"
+	defaultProfile         = "default"
+)
+
+// credentials are the REST API key and secret with the description of where they were taken from
+type credentials struct {
+	apiKey    string
+	apiSecret string
+	source    string
+}
+
+// resolveCredentials looks the credentials up in the following order, the first source providing
+// any of api_key and api_secret must provide both of them:
+//  1. api_key and api_secret provider arguments
+//  2. profile selected by the profile provider argument or the FIVETRAN_PROFILE environment variable
+//  3. FIVETRAN_APIKEY and FIVETRAN_APISECRET environment variables
+//  4. default profile of the credentials file, if the file exists
+func resolveCredentials(d *schema.ResourceData) (credentials, error) {
+	if apiKey, apiSecret := d.Get("api_key").(string), d.Get("api_secret").(string); apiKey != "" || apiSecret != "" {
+		return newCredentials(apiKey, apiSecret, "provider arguments api_key and api_secret")
+	}
+
+	credentialsFile, err := expandHomeDir(d.Get("credentials_file").(string))
+	if err != nil {
+		return credentials{}, err
+	}
+
+	if profile := d.Get("profile").(string); profile != "" {
+		profiles, err := readCredentialsFile(credentialsFile)
+		if err != nil {
+			return credentials{}, fmt.Errorf("unable to read profile %q: %v", profile, err)
+		}
+		values, ok := profiles[profile]
+		if !ok {
+			return credentials{}, fmt.Errorf("profile %q not found in credentials file %v", profile, credentialsFile)
+		}
+		return newCredentials(values["api_key"], values["api_secret"], fmt.Sprintf("profile %q of credentials file %v", profile, credentialsFile))
+	}
+
+	if apiKey, apiSecret := os.Getenv("FIVETRAN_APIKEY"), os.Getenv("FIVETRAN_APISECRET"); apiKey != "" || apiSecret != "" {
+		return newCredentials(apiKey, apiSecret, "environment variables FIVETRAN_APIKEY and FIVETRAN_APISECRET")
+	}
+
+	profiles, err := readCredentialsFile(credentialsFile)
+	if os.IsNotExist(err) {
+		return credentials{}, fmt.Errorf("no credentials found: set the api_key and api_secret provider arguments, "+
+			"the profile provider argument, the FIVETRAN_APIKEY and FIVETRAN_APISECRET environment variables "+
+			"or add the %q profile to credentials file %v", defaultProfile, credentialsFile)
+	}
+	if err != nil {
+		return credentials{}, err
+	}
+	values, ok := profiles[defaultProfile]
+	if !ok {
+		return credentials{}, fmt.Errorf("no credentials found: neither credentials are set nor profile is selected "+
+			"and profile %q not found in credentials file %v", defaultProfile, credentialsFile)
+	}
+	return newCredentials(values["api_key"], values["api_secret"], fmt.Sprintf("profile %q of credentials file %v", defaultProfile, credentialsFile))
+}
+
+var (
+	validatedCredentials      = make(map[[sha256.Size]byte]diag.Diagnostics)
+	validatedCredentialsMutex sync.Mutex
+)
+
+// validateCredentials checks the credentials with a single cheap authenticated request. The result is cached
+// for the lifetime of the provider process, unless the REST API couldn't be reached.
+func validateCredentials(ctx context.Context, client *fivetran.Client, apiUrl string, credentials credentials) diag.Diagnostics {
+	key := sha256.Sum256([]byte(apiUrl + "\x00" + credentials.apiKey + "\x00" + credentials.apiSecret))
+
+	validatedCredentialsMutex.Lock()
+	defer validatedCredentialsMutex.Unlock()
+
+	if diags, ok := validatedCredentials[key]; ok {
+		return diags
+	}
+
+	var diags diag.Diagnostics
+	resp, err := client.NewGroupsList().Limit(1).Do(ctx)
+	if err == nil {
+		validatedCredentials[key] = diags
+		return diags
+	}
+	if resp.Code == "" {
+		// the request failed before the REST API answered, e.g. because of a network error
+		return newDiagAppend(diags, diag.Error, "unable to validate credentials",
+			fmt.Sprintf("%v; credentials source: %v", err, credentials.source))
+	}
+
+	diags = newDiagAppend(diags, diag.Error, "invalid credentials",
+		fmt.Sprintf("the REST API rejected the credentials from %v: %v; code: %v; message: %v", credentials.source, err, resp.Code, resp.Message))
+	validatedCredentials[key] = diags
+	return diags
+}
+
+func newCredentials(apiKey, apiSecret, source string) (credentials, error) {
+	if apiKey == "" {
+		return credentials{}, fmt.Errorf("api_key is missing in %v", source)
+	}
+	if apiSecret == "" {
+		return credentials{}, fmt.Errorf("api_secret is missing in %v", source)
+	}
+	return credentials{apiKey: apiKey, apiSecret: apiSecret, source: source}, nil
+}
+
+// readCredentialsFile parses the INI formatted credentials file and returns the key/value pairs by profile name:
+//
+//	[default]
+//	api_key    = <key>
+//	api_secret = <secret>
+//
+// Lines starting with # or ; are comments.
+func readCredentialsFile(path string) (map[string]map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	result := make(map[string]map[string]string)
+	var profile map[string]string
+	scanner := bufio.NewScanner(file)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, ok := result[name]; !ok {
+				result[name] = make(map[string]string)
+			}
+			profile = result[name]
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("credentials file %v, line %v: expected key = value", path, lineNumber)
+			}
+			if profile == nil {
+				return nil, fmt.Errorf("credentials file %v, line %v: key %q is outside of a [profile] section", path, lineNumber, strings.TrimSpace(key))
+			}
+			profile[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read credentials file %v: %v", path, err)
+	}
+	return result, nil
+}
+
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to expand %v: %v", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
@@ -0,0 +1,163 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceConnectorSchema() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConnectorSchemaRead,
+		Schema: map[string]*schema.Schema{
+			"id":                   {Type: schema.TypeString, Required: true},
+			SCHEMA_CHANGE_HANDLING: {Type: schema.TypeString, Computed: true},
+			SCHEMA:                 dataSourceConnectorSchemaSchemas(),
+		},
+	}
+}
+
+func dataSourceConnectorSchemaSchemas() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				NAME:                  {Type: schema.TypeString, Computed: true},
+				"name_in_destination": {Type: schema.TypeString, Computed: true},
+				ENABLED:               {Type: schema.TypeString, Computed: true},
+				TABLE: {Type: schema.TypeList, Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							NAME:                     {Type: schema.TypeString, Computed: true},
+							"name_in_destination":    {Type: schema.TypeString, Computed: true},
+							ENABLED:                  {Type: schema.TypeString, Computed: true},
+							SYNC_MODE:                {Type: schema.TypeString, Computed: true},
+							"enabled_patch_settings": dataSourceConnectorSchemaEnabledPatchSettings(),
+							COLUMN: {Type: schema.TypeList, Computed: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										NAME:                     {Type: schema.TypeString, Computed: true},
+										"name_in_destination":    {Type: schema.TypeString, Computed: true},
+										ENABLED:                  {Type: schema.TypeString, Computed: true},
+										HASHED:                   {Type: schema.TypeString, Computed: true},
+										"enabled_patch_settings": dataSourceConnectorSchemaEnabledPatchSettings(),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceConnectorSchemaEnabledPatchSettings() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed":     {Type: schema.TypeString, Computed: true},
+				"reason_code": {Type: schema.TypeString, Computed: true},
+				"reason":      {Type: schema.TypeString, Computed: true},
+			},
+		},
+	}
+}
+
+func dataSourceConnectorSchemaRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*ProviderClient).Client
+	id := d.Get("id").(string)
+
+	var diags diag.Diagnostics
+
+	// the data source doesn't reload the schema config, a reload can change the connector schema
+	resp, err := client.NewConnectorSchemaDetails().ConnectorID(id).Do(ctx)
+	if err != nil {
+		if resp.Code == "NotFound_SchemaConfig" {
+			return newDiagAppend(diags, diag.Error, "service error",
+				fmt.Sprintf("the connector %v has no schema config yet, it is loaded by the first connector setup test, sync or schema reload", id))
+		}
+		return newDiagAppend(diags, diag.Error, "service error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+	}
+
+	if err := d.Set(SCHEMA_CHANGE_HANDLING, resp.Data.SchemaChangeHandling); err != nil {
+		return newDiagAppend(diags, diag.Error, "set error", err.Error())
+	}
+	if err := d.Set(SCHEMA, dataSourceConnectorSchemaFlattenSchemas(&resp)); err != nil {
+		return newDiagAppend(diags, diag.Error, "set error", err.Error())
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+// dataSourceConnectorSchemaFlattenSchemas receives a *fivetran.ConnectorSchemaDetailsResponse and returns the
+// []interface{} accepted by the "schema" list. Schemas, tables and columns are sorted by name, so the list indexes
+// are stable between reads.
+func dataSourceConnectorSchemaFlattenSchemas(resp *fivetran.ConnectorSchemaDetailsResponse) []interface{} {
+	schemas := make([]interface{}, 0, len(resp.Data.Schemas))
+	for sname, s := range resp.Data.Schemas {
+		if s == nil {
+			continue
+		}
+		tables := make([]interface{}, 0, len(s.Tables))
+		for tname, t := range s.Tables {
+			if t == nil {
+				continue
+			}
+			tables = append(tables, dataSourceConnectorSchemaFlattenTable(tname, t))
+		}
+		schemas = append(schemas, map[string]interface{}{
+			NAME:                  sname,
+			"name_in_destination": strPointerToStr(s.NameInDestination),
+			ENABLED:               boolPointerToStr(s.Enabled),
+			TABLE:                 dataSourceConnectorSchemaSortByName(tables),
+		})
+	}
+	return dataSourceConnectorSchemaSortByName(schemas)
+}
+
+func dataSourceConnectorSchemaFlattenTable(name string, t *fivetran.ConnectorSchemaConfigTableResponse) map[string]interface{} {
+	columns := make([]interface{}, 0, len(t.Columns))
+	for cname, c := range t.Columns {
+		if c == nil {
+			continue
+		}
+		columns = append(columns, map[string]interface{}{
+			NAME:                  cname,
+			"name_in_destination": strPointerToStr(c.NameInDestination),
+			ENABLED:               boolPointerToStr(c.Enabled),
+			HASHED:                boolPointerToStr(c.Hashed),
+			"enabled_patch_settings": dataSourceConnectorSchemaFlattenEnabledPatchSettings(
+				c.EnabledPatchSettings.Allowed, c.EnabledPatchSettings.ReasonCode, c.EnabledPatchSettings.Reason),
+		})
+	}
+	return map[string]interface{}{
+		NAME:                  name,
+		"name_in_destination": strPointerToStr(t.NameInDestination),
+		ENABLED:               boolPointerToStr(t.Enabled),
+		SYNC_MODE:             strPointerToStr(t.SyncMode),
+		"enabled_patch_settings": dataSourceConnectorSchemaFlattenEnabledPatchSettings(
+			t.EnabledPatchSettings.Allowed, t.EnabledPatchSettings.ReasonCode, t.EnabledPatchSettings.Reason),
+		COLUMN: dataSourceConnectorSchemaSortByName(columns),
+	}
+}
+
+func dataSourceConnectorSchemaFlattenEnabledPatchSettings(allowed *bool, reasonCode, reason *string) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"allowed":     boolPointerToStr(allowed),
+		"reason_code": strPointerToStr(reasonCode),
+		"reason":      strPointerToStr(reason),
+	}}
+}
+
+func dataSourceConnectorSchemaSortByName(items []interface{}) []interface{} {
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].(map[string]interface{})[NAME].(string) < items[j].(map[string]interface{})[NAME].(string)
+	})
+	return items
+}
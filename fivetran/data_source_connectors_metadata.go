@@ -36,7 +36,7 @@ func dataSourceConnectorsMetadataSchemaSources() *schema.Schema {
 
 func dataSourceConnectorsMetadataRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	resp, err := dataSourceConnectorsMetadataGetMetadata(client, ctx)
 	if err != nil {
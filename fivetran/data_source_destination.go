@@ -65,7 +65,7 @@ func dataSourceDestinationSchemaConfig() *schema.Schema {
 
 func dataSourceDestinationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewDestinationDetails()
 
 	resp, err := svc.DestinationID(d.Get("id").(string)).Do(ctx)
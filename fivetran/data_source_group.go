@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/fivetran/go-fivetran"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -22,7 +21,7 @@ func dataSourceGroup() *schema.Resource {
 
 func dataSourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewGroupDetails()
 
 	resp, err := svc.GroupID(d.Get("id").(string)).Do(ctx)
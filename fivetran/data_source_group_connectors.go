@@ -69,7 +69,7 @@ func dataSourceGroupConnectorsSchemaConnectors() *schema.Schema {
 
 func dataSourceGroupConnectorsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	id := d.Get("id").(string)
 	schema := d.Get("schema").(string)
@@ -41,7 +41,7 @@ func dataSourceGroupUsersSchemaUsers() *schema.Schema {
 
 func dataSourceGroupUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	id := d.Get("id").(string)
 
@@ -32,7 +32,7 @@ func dataSourceGroupSchemaGroups() *schema.Schema {
 
 func dataSourceGroupsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	resp, err := dataSourceGroupsGetGroups(client, ctx)
 	if err != nil {
@@ -35,7 +35,7 @@ func dataSourceUsers() *schema.Resource {
 
 func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	resp, err := dataSourceUsersGetUsers(client, ctx)
 	if err != nil {
@@ -3,17 +3,17 @@ package fivetran_test
 import (
 	"context"
 	"log"
+	"net/http"
 	"os"
 	"testing"
 
-	gofivetran "github.com/fivetran/go-fivetran"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-var client *gofivetran.Client
+var client *fivetran.ProviderClient
 var testProviders map[string]*schema.Provider
 var providerFactory = make(map[string]func() (*schema.Provider, error))
 var PredefinedGroupId string
@@ -36,8 +36,7 @@ func init() {
 		}
 	}
 
-	client = gofivetran.New(apiKey, apiSecret)
-	client.BaseURL(apiUrl)
+	client = fivetran.NewClient(apiKey, apiSecret, apiUrl, &http.Client{})
 	provider := fivetran.Provider()
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		return client, diag.Diagnostics{}
@@ -1,11 +1,12 @@
 package fivetran
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
@@ -34,6 +35,15 @@ func boolPointerToStr(b *bool) string {
 	return boolToStr(*b)
 }
 
+// strPointerToStr receives a string pointer and returns a string.
+// An empty string is returned if the pointer is nil.
+func strPointerToStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
 // strToInt receives a string and returns an int. A zero is returned
 // if an error is found while converting the string to int.
 func strToInt(s string) int {
@@ -90,11 +100,17 @@ func mapAddInt(msi map[string]interface{}, k string, v int) {
 	}
 }
 
-// mapAddIntPointer adds a non-nil *int to a map[string]interface{}.
-// This is currently not in use.
+// mapAddIntP adds the value of a non-nil *int to a map[string]interface{}
 func mapAddIntP(msi map[string]interface{}, k string, v *int) {
 	if v != nil {
-		msi[k] = v
+		msi[k] = *v
+	}
+}
+
+// mapAddBoolP adds the value of a non-nil *bool to a map[string]interface{}
+func mapAddBoolP(msi map[string]interface{}, k string, v *bool) {
+	if v != nil {
+		msi[k] = *v
 	}
 }
 
@@ -105,6 +121,44 @@ func mapAddXInterface(msi map[string]interface{}, k string, v []interface{}) {
 	}
 }
 
+// stateUpgradeStrToBool converts the string value of the key k of a raw state to a boolean, the empty
+// string is converted to null. The value that can't be converted is set to null and logged with the path.
+func stateUpgradeStrToBool(ctx context.Context, rawState map[string]interface{}, k, path string) {
+	v, ok := rawState[k].(string)
+	if !ok {
+		return
+	}
+	rawState[k] = nil
+	if v == "" {
+		return
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		tflog.Warn(ctx, "unable to convert the state value to a boolean, it is set to null", "path", path, "value", v)
+		return
+	}
+	rawState[k] = b
+}
+
+// stateUpgradeStrToInt converts the string value of the key k of a raw state to an integer, the empty
+// string is converted to null. The value that can't be converted is set to null and logged with the path.
+func stateUpgradeStrToInt(ctx context.Context, rawState map[string]interface{}, k, path string) {
+	v, ok := rawState[k].(string)
+	if !ok {
+		return
+	}
+	rawState[k] = nil
+	if v == "" {
+		return
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		tflog.Warn(ctx, "unable to convert the state value to an integer, it is set to null", "path", path, "value", v)
+		return
+	}
+	rawState[k] = i
+}
+
 // newDiag receives a diag.Severity, a summary, a detail, and returns a diag.Diagnostic
 func newDiag(severity diag.Severity, summary, detail string) diag.Diagnostic {
 	return diag.Diagnostic{
@@ -121,12 +175,6 @@ func newDiagAppend(diags diag.Diagnostics, severity diag.Severity, summary, deta
 	return diags
 }
 
-// debug is a temporary function. It should be improved to accept a variadic parameter
-// and its name should change to logDebug
-func debug(v interface{}) {
-	log.Println(fmt.Sprintf("[DEBUG] FIVETRAN: %s", v))
-}
-
 func copyMap(source map[string]interface{}) map[string]interface{} {
 	result := make(map[string]interface{})
 	for k, v := range source {
@@ -164,41 +212,49 @@ func filterMap(
 	return result
 }
 
+// destinationSchemaPrefixServices are the services that sync several source schemas, the connector schema is the
+// prefix of their destination schemas
+var destinationSchemaPrefixServices = map[string]bool{
+	"airtable":                true,
+	"dynamics_365_fo":         true,
+	"mongo":                   true,
+	"mongo_sharded":           true,
+	"aurora":                  true,
+	"mysql_azure":             true,
+	"maria_azure":             true,
+	"maria":                   true,
+	"mysql":                   true,
+	"google_cloud_mysql":      true,
+	"magento_mysql":           true,
+	"magento_mysql_rds":       true,
+	"maria_rds":               true,
+	"mysql_rds":               true,
+	"oracle":                  true,
+	"oracle_rac":              true,
+	"oracle_rds":              true,
+	"oracle_ebs":              true,
+	"aurora_postgres":         true,
+	"azure_postgres":          true,
+	"postgres":                true,
+	"google_cloud_postgresql": true,
+	"heroku_postgres":         true,
+	"postgres_rds":            true,
+	"azure_sql_db":            true,
+	"sql_server":              true,
+	"sql_server_rds":          true,
+}
+
+// destinationSchemaRenameServices are the services whose destination schema name and table Fivetran is confirmed to
+// rename in place through the modify endpoint, a destination schema change replaces the other connectors. None is
+// confirmed yet: Fivetran sets the destination schema when the connector is created.
+var destinationSchemaRenameServices = map[string]bool{}
+
 func readDestinationSchema(schema string, service string) []interface{} {
 	destination_schema := make([]interface{}, 1)
 
-	prefix_required_services := make(map[string]bool)
-	prefix_required_services["airtable"] = true
-	prefix_required_services["dynamics_365_fo"] = true
-	prefix_required_services["mongo"] = true
-	prefix_required_services["mongo_sharded"] = true
-	prefix_required_services["aurora"] = true
-	prefix_required_services["mysql_azure"] = true
-	prefix_required_services["maria_azure"] = true
-	prefix_required_services["maria"] = true
-	prefix_required_services["mysql"] = true
-	prefix_required_services["google_cloud_mysql"] = true
-	prefix_required_services["magento_mysql"] = true
-	prefix_required_services["magento_mysql_rds"] = true
-	prefix_required_services["maria_rds"] = true
-	prefix_required_services["mysql_rds"] = true
-	prefix_required_services["oracle"] = true
-	prefix_required_services["oracle_rac"] = true
-	prefix_required_services["oracle_rds"] = true
-	prefix_required_services["oracle_ebs"] = true
-	prefix_required_services["aurora_postgres"] = true
-	prefix_required_services["azure_postgres"] = true
-	prefix_required_services["postgres"] = true
-	prefix_required_services["google_cloud_postgresql"] = true
-	prefix_required_services["heroku_postgres"] = true
-	prefix_required_services["postgres_rds"] = true
-	prefix_required_services["azure_sql_db"] = true
-	prefix_required_services["sql_server"] = true
-	prefix_required_services["sql_server_rds"] = true
-
 	ds := make(map[string]interface{})
 
-	if prefix_required_services[service] {
+	if destinationSchemaPrefixServices[service] {
 		mapAddStr(ds, "prefix", schema)
 	} else {
 		s := strings.Split(schema, ".")
@@ -211,3 +267,71 @@ func readDestinationSchema(schema string, service string) []interface{} {
 	destination_schema[0] = ds
 	return destination_schema
 }
+
+// nearMatches returns up to 5 candidates close to value: the candidates containing value or contained in it, and the
+// candidates within the edit distance of a third of the value length, at least 2. The matches are case insensitive
+// and sorted by the distance.
+func nearMatches(value string, candidates []string) []string {
+	value = strings.ToLower(value)
+	maxDistance := len(value) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	distances := make(map[string]int)
+	var result []string
+	for _, c := range candidates {
+		lc := strings.ToLower(c)
+		distance := editDistance(value, lc)
+		if distance > maxDistance && !strings.Contains(lc, value) && !strings.Contains(value, lc) {
+			continue
+		}
+		if _, ok := distances[c]; !ok {
+			result = append(result, c)
+		}
+		distances[c] = distance
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if distances[result[i]] != distances[result[j]] {
+			return distances[result[i]] < distances[result[j]]
+		}
+		return result[i] < result[j]
+	})
+	if len(result) > 5 {
+		result = result[:5]
+	}
+	return result
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur := make([]int, len(rb)+1)
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
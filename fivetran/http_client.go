@@ -0,0 +1,84 @@
+package fivetran
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+)
+
+// newHttpClient returns the HttpClient used by the Fivetran SDK to perform REST API requests, each request times
+// out after timeout unless its context comes from withoutRequestTimeout. An empty proxyURL keeps the standard
+// HTTP_PROXY/HTTPS_PROXY environment handling, an empty caBundle keeps the system certificate pool.
+func newHttpClient(timeout time.Duration, proxyURL, caBundle string) (fivetran.HttpClient, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyURL != "" {
+		proxy, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy_url %q: %v", proxyURL, err)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	if caBundle != "" {
+		pool, err := newCertPool(caBundle)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return &requestTimeoutHttpClient{
+		client:     &http.Client{Timeout: timeout, Transport: transport},
+		longClient: &http.Client{Transport: transport},
+	}, nil
+}
+
+type withoutRequestTimeoutKey struct{}
+
+// withoutRequestTimeout returns a copy of ctx for the requests that run longer than the provider request_timeout,
+// e.g. a schema reload that returns when the reload finishes. Only the ctx deadline limits them.
+func withoutRequestTimeout(ctx context.Context) context.Context {
+	return context.WithValue(ctx, withoutRequestTimeoutKey{}, true)
+}
+
+// requestTimeoutHttpClient sends the requests with client, which has the provider request_timeout, and the requests
+// with a withoutRequestTimeout context with longClient. Both share the same transport.
+type requestTimeoutHttpClient struct {
+	client     *http.Client
+	longClient *http.Client
+}
+
+func (c *requestTimeoutHttpClient) Do(req *http.Request) (*http.Response, error) {
+	if v, ok := req.Context().Value(withoutRequestTimeoutKey{}).(bool); ok && v {
+		return c.longClient.Do(req)
+	}
+	return c.client.Do(req)
+}
+
+// newCertPool reads the PEM encoded certificates from the caBundle file and adds them to the
+// system certificate pool.
+func newCertPool(caBundle string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(caBundle)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ca_bundle: %v", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("ca_bundle %q doesn't contain any PEM encoded certificate", caBundle)
+	}
+
+	return pool, nil
+}
@@ -0,0 +1,178 @@
+package fivetran
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const redactedValue = "<redacted>"
+
+// loggingHttpClient wraps a fivetran.HttpClient and logs every request and response at the debug level.
+// Values of body fields and headers named after sensitive schema fields are redacted.
+type loggingHttpClient struct {
+	client        fivetran.HttpClient
+	sensitiveKeys map[string]bool
+}
+
+func newLoggingHttpClient(client fivetran.HttpClient) *loggingHttpClient {
+	return &loggingHttpClient{client: client, sensitiveKeys: providerSensitiveKeys()}
+}
+
+func (c *loggingHttpClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	tflog.Debug(ctx, "Fivetran API request",
+		"method", req.Method,
+		"path", req.URL.Path,
+		"query", req.URL.RawQuery,
+		"headers", c.redactHeaders(req.Header),
+		"body", c.redactBody(requestBody(req)))
+
+	start := time.Now()
+	resp, err := c.client.Do(req)
+	latency := time.Since(start)
+
+	if err != nil {
+		tflog.Debug(ctx, "Fivetran API request failed",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"latency", latency.String(),
+			"error", err.Error())
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var fivetranResponse struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	json.Unmarshal(body, &fivetranResponse)
+
+	tflog.Debug(ctx, "Fivetran API response",
+		"method", req.Method,
+		"path", req.URL.Path,
+		"status", resp.StatusCode,
+		"latency", latency.String(),
+		"code", fivetranResponse.Code,
+		"message", fivetranResponse.Message,
+		"headers", c.redactHeaders(resp.Header),
+		"body", c.redactBody(body))
+
+	return resp, nil
+}
+
+// requestBody returns a copy of the request body, the body itself is left untouched for the request.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	result, _ := io.ReadAll(body)
+	return result
+}
+
+func (c *loggingHttpClient) redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string)
+	for name, values := range headers {
+		key := strings.ReplaceAll(strings.ToLower(name), "-", "_")
+		if key == "authorization" || c.sensitiveKeys[key] {
+			result[name] = redactedValue
+		} else {
+			result[name] = strings.Join(values, ", ")
+		}
+	}
+	return result
+}
+
+func (c *loggingHttpClient) redactBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		// the content isn't logged as it can't be redacted
+		return fmt.Sprintf("<%v bytes of non-JSON content>", len(body))
+	}
+	var result bytes.Buffer
+	encoder := json.NewEncoder(&result)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(c.redactValue(value)); err != nil {
+		return fmt.Sprintf("<%v bytes of JSON content>", len(body))
+	}
+	return strings.TrimSuffix(result.String(), "\n")
+}
+
+func (c *loggingHttpClient) redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{})
+		for key, item := range v {
+			if c.sensitiveKeys[key] && item != nil {
+				result[key] = redactedValue
+			} else {
+				result[key] = c.redactValue(item)
+			}
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = c.redactValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+var (
+	sensitiveKeys     map[string]bool
+	sensitiveKeysOnce sync.Once
+)
+
+// providerSensitiveKeys returns the names of all fields marked as Sensitive in the provider,
+// resources and data sources schemas.
+func providerSensitiveKeys() map[string]bool {
+	sensitiveKeysOnce.Do(func() {
+		provider := Provider()
+		sensitiveKeys = make(map[string]bool)
+		collectSensitiveKeys(provider.Schema, sensitiveKeys)
+		for _, resource := range provider.ResourcesMap {
+			collectSensitiveKeys(resource.Schema, sensitiveKeys)
+		}
+		for _, dataSource := range provider.DataSourcesMap {
+			collectSensitiveKeys(dataSource.Schema, sensitiveKeys)
+		}
+	})
+	return sensitiveKeys
+}
+
+func collectSensitiveKeys(schemaMap map[string]*schema.Schema, result map[string]bool) {
+	for key, field := range schemaMap {
+		if field.Sensitive {
+			result[key] = true
+		}
+		if elem, ok := field.Elem.(*schema.Resource); ok {
+			collectSensitiveKeys(elem.Schema, result)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package fivetran
+
+import (
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/fivetran/go-fivetran"
+	"golang.org/x/time/rate"
+)
+
+// rateLimitedHttpClient wraps a fivetran.HttpClient and throttles the requests with a token bucket limiter and
+// a cap on the number of requests in flight. A single instance is shared by all resources and data sources,
+// so the limits apply to the provider as a whole regardless of Terraform parallelism.
+type rateLimitedHttpClient struct {
+	client    fivetran.HttpClient
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+}
+
+// newRateLimitedHttpClient returns a rateLimitedHttpClient. Zero requestsPerSecond or maxConcurrentRequests
+// disables the corresponding limit.
+func newRateLimitedHttpClient(client fivetran.HttpClient, requestsPerSecond float64, maxConcurrentRequests int) *rateLimitedHttpClient {
+	result := &rateLimitedHttpClient{client: client}
+	if requestsPerSecond > 0 {
+		burst := int(requestsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		result.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), burst)
+	}
+	if maxConcurrentRequests > 0 {
+		result.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+	return result
+}
+
+func (c *rateLimitedHttpClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	if c.semaphore != nil {
+		select {
+		case c.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := c.releaseFunc()
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			// the limiter fails right away when the request can't be sent before the ctx deadline
+			release()
+			return nil, err
+		}
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		release()
+		return resp, err
+	}
+
+	// the request is in flight until its response body is read
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+func (c *rateLimitedHttpClient) releaseFunc() func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if c.semaphore != nil {
+				<-c.semaphore
+			}
+		})
+	}
+}
+
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
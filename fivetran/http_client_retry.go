@@ -0,0 +1,141 @@
+package fivetran
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// retryHttpClient wraps a fivetran.HttpClient and retries requests that failed with a transient error:
+// rate limited requests (429) are always retried as they weren't processed by the REST API, server errors (5xx)
+// and network errors are retried only for idempotent methods unless retryNonIdempotent is set.
+type retryHttpClient struct {
+	client             fivetran.HttpClient
+	maxRetries         int
+	waitMin            time.Duration
+	waitMax            time.Duration
+	retryNonIdempotent bool
+}
+
+func (c *retryHttpClient) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := c.client.Do(attemptReq)
+
+		if attempt >= c.maxRetries || !c.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := c.backoff(attempt, resp)
+		tflog.Debug(req.Context(), "Retrying Fivetran API request",
+			"method", req.Method,
+			"path", req.URL.Path,
+			"reason", retryReason(resp, err),
+			"wait", wait.String(),
+			"retry", attempt+1,
+			"max_retries", c.maxRetries)
+
+		if resp != nil {
+			// drain the body to let the connection be reused
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *retryHttpClient) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	retryable := c.retryNonIdempotent || isIdempotentMethod(req.Method)
+	if err != nil {
+		return retryable
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	return retryable && resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
+
+// backoff returns the wait time before the next attempt: the Retry-After header value if the response has it,
+// otherwise waitMin doubled on each attempt and limited by waitMax.
+func (c *retryHttpClient) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+	wait := c.waitMin << uint(attempt)
+	if wait > c.waitMax || wait < c.waitMin {
+		wait = c.waitMax
+	}
+	return wait
+}
+
+// parseRetryAfter parses the Retry-After header value given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// rewindRequest returns the request to send on the given attempt. The request body has been consumed
+// by the previous attempt, so it is recreated from GetBody.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+	if req.GetBody == nil {
+		return nil, fmt.Errorf("%v %v: request body can't be sent again", req.Method, req.URL.Path)
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	result := req.Clone(req.Context())
+	result.Body = body
+	return result, nil
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func retryReason(resp *http.Response, err error) string {
+	if err != nil {
+		return fmt.Sprint(err)
+	}
+	return fmt.Sprintf("status code: %v", resp.StatusCode)
+}
@@ -2,8 +2,10 @@ package fivetran
 
 import (
 	"context"
+	"fmt"
+	"time"
 
-	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -11,11 +13,35 @@ import (
 var limit = 1000         // REST API response objects limit per HTTP request
 const version = "0.6.17" // Current provider version
 
+const (
+	defaultApiUrl         = "https://api.fivetran.com/v1"
+	defaultRequestTimeout = "60s"
+	defaultMaxRetries     = 4
+	defaultRetryWaitMin   = "1s"
+	defaultRetryWaitMax   = "30s"
+)
+
 func Provider() *schema.Provider {
 	return &schema.Provider{
 		Schema: map[string]*schema.Schema{
-			"api_key":    {Type: schema.TypeString, Required: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_APIKEY", nil)},
-			"api_secret": {Type: schema.TypeString, Required: true, Sensitive: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_APISECRET", nil)},
+			"api_key":         {Type: schema.TypeString, Optional: true},
+			"api_secret":      {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"api_url":         {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_API_URL", defaultApiUrl)},
+			"request_timeout": {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_REQUEST_TIMEOUT", defaultRequestTimeout), ValidateFunc: providerDurationValidateFunc},
+			"proxy_url":       {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_PROXY_URL", nil)},
+			"ca_bundle":       {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_CA_BUNDLE", nil)},
+
+			"profile":              {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_PROFILE", nil)},
+			"credentials_file":     {Type: schema.TypeString, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_CREDENTIALS_FILE", defaultCredentialsFile)},
+			"validate_credentials": {Type: schema.TypeBool, Optional: true, DefaultFunc: schema.EnvDefaultFunc("FIVETRAN_VALIDATE_CREDENTIALS", false)},
+
+			"max_retries":          {Type: schema.TypeInt, Optional: true, Default: defaultMaxRetries, ValidateFunc: providerNonNegativeIntValidateFunc},
+			"retry_wait_min":       {Type: schema.TypeString, Optional: true, Default: defaultRetryWaitMin, ValidateFunc: providerDurationValidateFunc},
+			"retry_wait_max":       {Type: schema.TypeString, Optional: true, Default: defaultRetryWaitMax, ValidateFunc: providerDurationValidateFunc},
+			"retry_non_idempotent": {Type: schema.TypeBool, Optional: true, Default: false},
+
+			"requests_per_second":     {Type: schema.TypeFloat, Optional: true, Default: 0.0, ValidateFunc: providerNonNegativeFloatValidateFunc},
+			"max_concurrent_requests": {Type: schema.TypeInt, Optional: true, Default: 0, ValidateFunc: providerNonNegativeIntValidateFunc},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"fivetran_user":                    resourceUser(),
@@ -24,6 +50,9 @@ func Provider() *schema.Provider {
 			"fivetran_destination":             resourceDestination(),
 			"fivetran_connector":               resourceConnector(),
 			"fivetran_connector_schema_config": resourceSchemaConfig(),
+			"fivetran_connector_sync":          resourceConnectorSync(),
+			"fivetran_connector_resync":        resourceConnectorResync(),
+			"fivetran_connector_schema_reload": resourceConnectorSchemaReload(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"fivetran_user":                dataSourceUser(),
@@ -35,13 +64,76 @@ func Provider() *schema.Provider {
 			"fivetran_destination":         dataSourceDestination(),
 			"fivetran_connectors_metadata": dataSourceConnectorsMetadata(),
 			"fivetran_connector":           dataSourceConnector(),
+			"fivetran_connector_schema":    dataSourceConnectorSchema(),
 		},
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	fivetranClient := fivetran.New(d.Get("api_key").(string), d.Get("api_secret").(string))
-	fivetranClient.CustomUserAgent("terraform-provider-fivetran/" + version)
-	return fivetranClient, diag.Diagnostics{}
+	var diags diag.Diagnostics
+
+	durations := make(map[string]time.Duration)
+	for _, key := range []string{"request_timeout", "retry_wait_min", "retry_wait_max"} {
+		value, err := time.ParseDuration(d.Get(key).(string))
+		if err != nil {
+			return nil, newDiagAppend(diags, diag.Error, "provider configuration error", fmt.Sprintf("%v: %v", key, err))
+		}
+		durations[key] = value
+	}
+	if durations["retry_wait_min"] > durations["retry_wait_max"] {
+		return nil, newDiagAppend(diags, diag.Error, "provider configuration error", "retry_wait_min can't be greater than retry_wait_max")
+	}
+
+	credentials, err := resolveCredentials(d)
+	if err != nil {
+		return nil, newDiagAppend(diags, diag.Error, "provider credentials error", fmt.Sprint(err))
+	}
+	tflog.Info(ctx, "Fivetran credentials source", "source", credentials.source)
+
+	httpClient, err := newHttpClient(durations["request_timeout"], d.Get("proxy_url").(string), d.Get("ca_bundle").(string))
+	if err != nil {
+		return nil, newDiagAppend(diags, diag.Error, "provider configuration error", fmt.Sprint(err))
+	}
+
+	// all SDK calls share the limiter, retries included
+	rateLimitedClient := newRateLimitedHttpClient(newLoggingHttpClient(httpClient), d.Get("requests_per_second").(float64), d.Get("max_concurrent_requests").(int))
+
+	fivetranClient := NewClient(credentials.apiKey, credentials.apiSecret, d.Get("api_url").(string), &retryHttpClient{
+		client:             rateLimitedClient,
+		maxRetries:         d.Get("max_retries").(int),
+		waitMin:            durations["retry_wait_min"],
+		waitMax:            durations["retry_wait_max"],
+		retryNonIdempotent: d.Get("retry_non_idempotent").(bool),
+	})
+
+	if d.Get("validate_credentials").(bool) {
+		if diags = append(diags, validateCredentials(ctx, fivetranClient.Client, d.Get("api_url").(string), credentials)...); diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return fivetranClient, diags
+}
+
+func providerDurationValidateFunc(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if d, err := time.ParseDuration(v); err != nil || d < 0 {
+		errs = append(errs, fmt.Errorf("%q expected a non-negative duration such as \"30s\" or \"2m\", got: %v", key, v))
+	}
+	return
+}
+
+func providerNonNegativeIntValidateFunc(val interface{}, key string) (warns []string, errs []error) {
+	if v := val.(int); v < 0 {
+		errs = append(errs, fmt.Errorf("%q expected a non-negative value, got: %v", key, v))
+	}
+	return
+}
+
+func providerNonNegativeFloatValidateFunc(val interface{}, key string) (warns []string, errs []error) {
+	if v := val.(float64); v < 0 {
+		errs = append(errs, fmt.Errorf("%q expected a non-negative value, got: %v", key, v))
+	}
+	return
 }
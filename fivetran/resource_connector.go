@@ -2,57 +2,89 @@ package fivetran
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strconv"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceConnector() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceConnectorCreate,
-		ReadContext:   resourceConnectorRead,
-		UpdateContext: resourceConnectorUpdate,
-		DeleteContext: resourceConnectorDelete,
-		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
-		Schema: map[string]*schema.Schema{
-			"id":                 {Type: schema.TypeString, Computed: true},
-			"group_id":           {Type: schema.TypeString, Required: true, ForceNew: true},
-			"service":            {Type: schema.TypeString, Required: true, ForceNew: true},
-			"service_version":    {Type: schema.TypeString, Computed: true},
-			"destination_schema": resourceConnectorDestinationSchemaSchema(),
-			"name":               {Type: schema.TypeString, Computed: true},
-			"connected_by":       {Type: schema.TypeString, Computed: true},
-			"created_at":         {Type: schema.TypeString, Computed: true},
-			"succeeded_at":       {Type: schema.TypeString, Computed: true},
-			"failed_at":          {Type: schema.TypeString, Computed: true},
-			"sync_frequency":     {Type: schema.TypeString, Required: true},
-			"daily_sync_time":    {Type: schema.TypeString, Optional: true},
-			"schedule_type":      {Type: schema.TypeString, Computed: true},
-			"trust_certificates": {Type: schema.TypeString, Optional: true},
-			"trust_fingerprints": {Type: schema.TypeString, Optional: true},
-			"run_setup_tests":    {Type: schema.TypeString, Optional: true},
-			"paused":             {Type: schema.TypeString, Required: true},
-			"pause_after_trial":  {Type: schema.TypeString, Required: true},
-			"status":             resourceConnectorSchemaStatus(),
-			"config":             resourceConnectorSchemaConfig(),
-			"auth":               resourceConnectorSchemaAuth(),
-			"last_updated":       {Type: schema.TypeString, Computed: true}, // internal
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceConnectorCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceConnectorRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceConnectorUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceConnectorDelete),
+		Timeouts:      resourceTimeouts(),
+		Importer:      &schema.ResourceImporter{StateContext: resourceConnectorImport},
+		CustomizeDiff: resourceConnectorCustomizeDiff,
+		Schema:        resourceConnectorSchema(),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceConnectorV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceConnectorStateUpgradeV0,
+			},
 		},
 	}
 }
 
+// resourceConnectorSyncFrequencies are the sync frequencies in minutes supported by Fivetran
+var resourceConnectorSyncFrequencies = []int{5, 15, 30, 60, 120, 180, 360, 480, 720, 1440}
+
+func resourceConnectorSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id":                         {Type: schema.TypeString, Computed: true},
+		"group_id":                   {Type: schema.TypeString, Required: true, ForceNew: true},
+		"service":                    {Type: schema.TypeString, Required: true, ForceNew: true},
+		"service_version":            {Type: schema.TypeString, Computed: true},
+		"destination_schema":         resourceConnectorDestinationSchemaSchema(),
+		"name":                       {Type: schema.TypeString, Computed: true},
+		"connected_by":               {Type: schema.TypeString, Computed: true},
+		"created_at":                 {Type: schema.TypeString, Computed: true},
+		"succeeded_at":               {Type: schema.TypeString, Computed: true},
+		"failed_at":                  {Type: schema.TypeString, Computed: true},
+		"sync_frequency":             {Type: schema.TypeInt, Required: true, ValidateFunc: validation.IntInSlice(resourceConnectorSyncFrequencies)},
+		"daily_sync_time":            {Type: schema.TypeString, Optional: true},
+		"schedule_type":              {Type: schema.TypeString, Computed: true},
+		"trust_certificates":         {Type: schema.TypeBool, Optional: true},
+		"trust_fingerprints":         {Type: schema.TypeBool, Optional: true},
+		"run_setup_tests":            {Type: schema.TypeBool, Optional: true},
+		"fail_on_setup_test_warning": {Type: schema.TypeBool, Optional: true, Default: false},
+		"setup_tests":                resourceConnectorSchemaSetupTests(),
+		"wait_for_setup":             {Type: schema.TypeBool, Optional: true, Default: false},
+		"target_setup_state":         {Type: schema.TypeString, Optional: true, Default: "connected", ValidateFunc: validation.StringInSlice([]string{"incomplete", "connected"}, false)},
+		"setup_poll_interval":        {Type: schema.TypeString, Optional: true, Default: "10s", ValidateFunc: providerDurationValidateFunc},
+		"paused":                     {Type: schema.TypeBool, Required: true},
+		"pause_after_trial":          {Type: schema.TypeBool, Required: true},
+		"status":                     resourceConnectorSchemaStatus(),
+		"config":                     resourceConnectorSchemaConfig(),
+		"config_json":                {Type: schema.TypeString, Optional: true, Sensitive: true, ValidateFunc: resourceConnectorConfigJsonValidateFunc, DiffSuppressFunc: resourceConnectorConfigJsonDiffSuppressFunc},
+		"secrets_version":            {Type: schema.TypeInt, Optional: true},
+		"auth":                       resourceConnectorSchemaAuth(),
+		"last_updated":               {Type: schema.TypeString, Computed: true}, // internal
+	}
+}
+
 func resourceConnectorDestinationSchemaSchema() *schema.Schema {
 	return &schema.Schema{Type: schema.TypeList, Required: true,
 		MaxItems: 1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				"name":   {Type: schema.TypeString, Optional: true, ForceNew: true},
-				"table":  {Type: schema.TypeString, Optional: true, ForceNew: true},
-				"prefix": {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"name": {Type: schema.TypeString, Optional: true,
+					Description: "Changing it replaces the connector, unless Fivetran renames the destination schema of the service in place."},
+				"table": {Type: schema.TypeString, Optional: true,
+					Description: "Changing it replaces the connector, unless Fivetran renames the destination table of the service in place."},
+				"prefix": {Type: schema.TypeString, Optional: true,
+					Description: "Changing it replaces the connector, Fivetran prefixes the destination schemas with it and can't rename them."},
 			},
 		},
 	}
@@ -87,328 +119,26 @@ func resourceConnectorSchemaStatus() *schema.Schema {
 	}
 }
 
-func resourceConnectorSchemaConfig() *schema.Schema {
-	return &schema.Schema{Type: schema.TypeList, Optional: true, Computed: true, MaxItems: 1,
+func resourceConnectorSchemaSetupTests() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Computed: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				// Readonly config fields
-				"latest_version":            {Type: schema.TypeString, Computed: true},
-				"authorization_method":      {Type: schema.TypeString, Computed: true},
-				"service_version":           {Type: schema.TypeString, Computed: true},
-				"last_synced_changes__utc_": {Type: schema.TypeString, Computed: true},
-
-				// Sensitive config fields, Fivetran returns this fields masked
-				"oauth_token":        {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"oauth_token_secret": {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"consumer_key":       {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"client_secret":      {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"private_key":        {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"s3role_arn":         {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"ftp_password":       {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"sftp_password":      {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"api_key":            {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"role_arn":           {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"password":           {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"secret_key":         {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"pem_certificate":    {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"access_token":       {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"api_secret":         {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"api_access_token":   {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"secret":             {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"consumer_secret":    {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"secrets":            {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"api_token":          {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"encryption_key":     {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"pat":                {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"function_trigger":   {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"token_key":          {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"token_secret":       {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"agent_password":     {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"asm_password":       {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"login_password":     {Type: schema.TypeString, Optional: true, Sensitive: true},
-
-				// Fields that always have default value (and should be marked as Computed to prevent drifting)
-				// Boolean values
-				"is_ftps":                           {Type: schema.TypeString, Optional: true, Computed: true},
-				"sftp_is_key_pair":                  {Type: schema.TypeString, Optional: true, Computed: true},
-				"sync_data_locker":                  {Type: schema.TypeString, Optional: true, Computed: true},
-				"enable_all_dimension_combinations": {Type: schema.TypeString, Optional: true, Computed: true},
-				"update_config_on_each_sync":        {Type: schema.TypeString, Optional: true, Computed: true},
-				"on_premise":                        {Type: schema.TypeString, Optional: true, Computed: true},
-				"use_api_keys":                      {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_new_package":                    {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_multi_entity_feature_enabled":   {Type: schema.TypeString, Optional: true, Computed: true},
-				"always_encrypted":                  {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_secure":                         {Type: schema.TypeString, Optional: true, Computed: true},
-				"use_webhooks":                      {Type: schema.TypeString, Optional: true, Computed: true},
-				"eu_region":                         {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_keypair":                        {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_account_level_connector":        {Type: schema.TypeString, Optional: true, Computed: true},
-				"use_oracle_rac":                    {Type: schema.TypeString, Optional: true, Computed: true},
-				"asm_option":                        {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_single_table_mode":              {Type: schema.TypeString, Optional: true, Computed: true},
-				"is_public":                         {Type: schema.TypeString, Optional: true, Computed: true},
-				"empty_header":                      {Type: schema.TypeString, Optional: true, Computed: true},
-
-				// Enum & int values
-				"connection_type":                      {Type: schema.TypeString, Optional: true, Computed: true},
-				"sync_method":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"sync_mode":                            {Type: schema.TypeString, Optional: true, Computed: true},
-				"date_granularity":                     {Type: schema.TypeString, Optional: true, Computed: true},
-				"timeframe_months":                     {Type: schema.TypeString, Optional: true, Computed: true},
-				"report_type":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"aggregation":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"config_type":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"prebuilt_report":                      {Type: schema.TypeString, Optional: true, Computed: true},
-				"action_report_time":                   {Type: schema.TypeString, Optional: true, Computed: true},
-				"click_attribution_window":             {Type: schema.TypeString, Optional: true, Computed: true},
-				"view_attribution_window":              {Type: schema.TypeString, Optional: true, Computed: true},
-				"conversion_window_size":               {Type: schema.TypeString, Optional: true, Computed: true},
-				"view_through_attribution_window_size": {Type: schema.TypeString, Optional: true, Computed: true},
-				"post_click_attribution_window_size":   {Type: schema.TypeString, Optional: true, Computed: true},
-				"update_method":                        {Type: schema.TypeString, Optional: true, Computed: true},
-				"swipe_attribution_window":             {Type: schema.TypeString, Optional: true, Computed: true},
-				"api_type":                             {Type: schema.TypeString, Optional: true, Computed: true},
-				"auth_type":                            {Type: schema.TypeString, Optional: true, Computed: true},
-				"sync_format":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"app_sync_mode":                        {Type: schema.TypeString, Optional: true, Computed: true},
-				"sales_account_sync_mode":              {Type: schema.TypeString, Optional: true, Computed: true},
-				"finance_account_sync_mode":            {Type: schema.TypeString, Optional: true, Computed: true},
-				"source":                               {Type: schema.TypeString, Optional: true, Computed: true},
-				"file_type":                            {Type: schema.TypeString, Optional: true, Computed: true},
-				"compression":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"on_error":                             {Type: schema.TypeString, Optional: true, Computed: true},
-				"append_file_option":                   {Type: schema.TypeString, Optional: true, Computed: true},
-				"engagement_attribution_window":        {Type: schema.TypeString, Optional: true, Computed: true},
-				"conversion_report_time":               {Type: schema.TypeString, Optional: true, Computed: true},
-				"skip_before":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"skip_after":                           {Type: schema.TypeString, Optional: true, Computed: true},
-				"ftp_port":                             {Type: schema.TypeString, Optional: true, Computed: true},
-				"sftp_port":                            {Type: schema.TypeString, Optional: true, Computed: true},
-				"port":                                 {Type: schema.TypeString, Optional: true, Computed: true},
-				"tunnel_port":                          {Type: schema.TypeString, Optional: true, Computed: true},
-				"daily_api_call_limit":                 {Type: schema.TypeString, Optional: true, Computed: true},
-				"api_quota":                            {Type: schema.TypeString, Optional: true, Computed: true},
-				"agent_port":                           {Type: schema.TypeString, Optional: true, Computed: true},
-
-				// For db-like connectors it's a readonly field, but it also used in Braintree connector as public field
-				"public_key": {Type: schema.TypeString, Optional: true, Computed: true},
-
-				// external_id is used among AWS connectors and correcponds to group_id. For some connectors it is computed, some expect it as a parameter.
-				"external_id": {Type: schema.TypeString, Optional: true, Computed: true},
-
-				"asm_oracle_home":       {Type: schema.TypeString, Optional: true},
-				"asm_tns":               {Type: schema.TypeString, Optional: true},
-				"pdb_name":              {Type: schema.TypeString, Optional: true},
-				"agent_host":            {Type: schema.TypeString, Optional: true},
-				"agent_user":            {Type: schema.TypeString, Optional: true},
-				"agent_public_cert":     {Type: schema.TypeString, Optional: true},
-				"agent_ora_home":        {Type: schema.TypeString, Optional: true},
-				"tns":                   {Type: schema.TypeString, Optional: true},
-				"asm_user":              {Type: schema.TypeString, Optional: true},
-				"sap_user":              {Type: schema.TypeString, Optional: true},
-				"sheet_id":              {Type: schema.TypeString, Optional: true},
-				"named_range":           {Type: schema.TypeString, Optional: true},
-				"client_id":             {Type: schema.TypeString, Optional: true},
-				"technical_account_id":  {Type: schema.TypeString, Optional: true},
-				"organization_id":       {Type: schema.TypeString, Optional: true},
-				"s3bucket":              {Type: schema.TypeString, Optional: true},
-				"abs_connection_string": {Type: schema.TypeString, Optional: true},
-				"abs_container_name":    {Type: schema.TypeString, Optional: true},
-				"folder_id":             {Type: schema.TypeString, Optional: true},
-				"ftp_host":              {Type: schema.TypeString, Optional: true},
-				"ftp_user":              {Type: schema.TypeString, Optional: true},
-				"sftp_host":             {Type: schema.TypeString, Optional: true},
-				"sftp_user":             {Type: schema.TypeString, Optional: true},
-				"bucket":                {Type: schema.TypeString, Optional: true},
-				"prefix":                {Type: schema.TypeString, Optional: true},
-				"pattern":               {Type: schema.TypeString, Optional: true},
-				"archive_pattern":       {Type: schema.TypeString, Optional: true},
-				"null_sequence":         {Type: schema.TypeString, Optional: true},
-				"delimiter":             {Type: schema.TypeString, Optional: true},
-				"escape_char":           {Type: schema.TypeString, Optional: true},
-				"auth_mode":             {Type: schema.TypeString, Optional: true},
-				"certificate":           {Type: schema.TypeString, Optional: true},
-				"consumer_group":        {Type: schema.TypeString, Optional: true},
-				"servers":               {Type: schema.TypeString, Optional: true},
-				"message_type":          {Type: schema.TypeString, Optional: true},
-				"sync_type":             {Type: schema.TypeString, Optional: true},
-				"security_protocol":     {Type: schema.TypeString, Optional: true},
-				"access_key_id":         {Type: schema.TypeString, Optional: true},
-				"home_folder":           {Type: schema.TypeString, Optional: true},
-				"function":              {Type: schema.TypeString, Optional: true},
-				"region":                {Type: schema.TypeString, Optional: true},
-				"container_name":        {Type: schema.TypeString, Optional: true},
-				"connection_string":     {Type: schema.TypeString, Optional: true},
-				"function_app":          {Type: schema.TypeString, Optional: true},
-				"function_name":         {Type: schema.TypeString, Optional: true},
-				"function_key":          {Type: schema.TypeString, Optional: true},
-				"merchant_id":           {Type: schema.TypeString, Optional: true},
-				"api_url":               {Type: schema.TypeString, Optional: true},
-				"cloud_storage_type":    {Type: schema.TypeString, Optional: true},
-				"s3external_id":         {Type: schema.TypeString, Optional: true},
-				"s3folder":              {Type: schema.TypeString, Optional: true},
-				"gcs_bucket":            {Type: schema.TypeString, Optional: true},
-				"gcs_folder":            {Type: schema.TypeString, Optional: true},
-				"instance":              {Type: schema.TypeString, Optional: true},
-				"aws_region_code":       {Type: schema.TypeString, Optional: true},
-				"subdomain":             {Type: schema.TypeString, Optional: true},
-				"host":                  {Type: schema.TypeString, Optional: true},
-				"user":                  {Type: schema.TypeString, Optional: true},
-				"network_code":          {Type: schema.TypeString, Optional: true},
-				"customer_id":           {Type: schema.TypeString, Optional: true},
-				"project_id":            {Type: schema.TypeString, Optional: true},
-				"dataset_id":            {Type: schema.TypeString, Optional: true},
-				"bucket_name":           {Type: schema.TypeString, Optional: true},
-				"config_method":         {Type: schema.TypeString, Optional: true},
-				"query_id":              {Type: schema.TypeString, Optional: true},
-				"path":                  {Type: schema.TypeString, Optional: true},
-				"endpoint":              {Type: schema.TypeString, Optional: true},
-				"identity":              {Type: schema.TypeString, Optional: true},
-				"domain_name":           {Type: schema.TypeString, Optional: true},
-				"resource_url":          {Type: schema.TypeString, Optional: true},
-				"tunnel_host":           {Type: schema.TypeString, Optional: true},
-				"tunnel_user":           {Type: schema.TypeString, Optional: true},
-				"database":              {Type: schema.TypeString, Optional: true},
-				"datasource":            {Type: schema.TypeString, Optional: true},
-				"account":               {Type: schema.TypeString, Optional: true},
-				"role":                  {Type: schema.TypeString, Optional: true},
-				"email":                 {Type: schema.TypeString, Optional: true},
-				"account_id":            {Type: schema.TypeString, Optional: true},
-				"server_url":            {Type: schema.TypeString, Optional: true},
-				"user_key":              {Type: schema.TypeString, Optional: true},
-				"api_version":           {Type: schema.TypeString, Optional: true},
-				"time_zone":             {Type: schema.TypeString, Optional: true},
-				"integration_key":       {Type: schema.TypeString, Optional: true},
-				"domain":                {Type: schema.TypeString, Optional: true},
-				"replication_slot":      {Type: schema.TypeString, Optional: true},
-				"publication_name":      {Type: schema.TypeString, Optional: true},
-				"data_center":           {Type: schema.TypeString, Optional: true},
-				"sub_domain":            {Type: schema.TypeString, Optional: true},
-				"test_table_name":       {Type: schema.TypeString, Optional: true},
-				"shop":                  {Type: schema.TypeString, Optional: true},
-				"sid":                   {Type: schema.TypeString, Optional: true},
-				"key":                   {Type: schema.TypeString, Optional: true},
-				"bucket_service":        {Type: schema.TypeString, Optional: true},
-				"user_name":             {Type: schema.TypeString, Optional: true},
-				"username":              {Type: schema.TypeString, Optional: true},
-				"report_url":            {Type: schema.TypeString, Optional: true},
-				"unique_id":             {Type: schema.TypeString, Optional: true},
-				"base_url":              {Type: schema.TypeString, Optional: true},
-				"entity_id":             {Type: schema.TypeString, Optional: true},
-				"soap_uri":              {Type: schema.TypeString, Optional: true},
-				"user_id":               {Type: schema.TypeString, Optional: true},
-				"share_url":             {Type: schema.TypeString, Optional: true},
-				"organization":          {Type: schema.TypeString, Optional: true},
-				"access_key":            {Type: schema.TypeString, Optional: true},
-				"domain_host_name":      {Type: schema.TypeString, Optional: true},
-				"client_name":           {Type: schema.TypeString, Optional: true},
-				"domain_type":           {Type: schema.TypeString, Optional: true},
-				"connection_method":     {Type: schema.TypeString, Optional: true},
-				"group_name":            {Type: schema.TypeString, Optional: true},
-				"company_id":            {Type: schema.TypeString, Optional: true},
-				"environment":           {Type: schema.TypeString, Optional: true},
-				"list_strategy":         {Type: schema.TypeString, Optional: true},
-
-				// Collections
-				"report_suites":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"elements":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"metrics":                  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"advertisables":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"dimensions":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"selected_exports":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"apps":                     {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"sales_accounts":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"finance_accounts":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"projects":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"user_profiles":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"report_configuration_ids": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"accounts":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"fields":                   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"breakdowns":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"action_breakdowns":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"pages":                    {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"repositories":             {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"dimension_attributes":     {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"columns":                  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"manager_accounts":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"profiles":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"site_urls":                {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"api_keys":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"advertisers_id":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"hosts":                    {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"advertisers":              {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"organizations":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"account_ids":              {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"packed_mode_tables":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-				"properties":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-
-				"secrets_list": {Type: schema.TypeSet, Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"key":   {Type: schema.TypeString, Required: true},
-							"value": {Type: schema.TypeString, Required: true, Sensitive: true},
-						},
-					},
-				},
-
-				"adobe_analytics_configurations": {Type: schema.TypeSet, Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"sync_mode":          {Type: schema.TypeString, Optional: true},
-							"report_suites":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"elements":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"metrics":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"calculated_metrics": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"segments":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-						},
-					},
-				},
-				"reports": {Type: schema.TypeSet, Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"table":           {Type: schema.TypeString, Optional: true},
-							"config_type":     {Type: schema.TypeString, Optional: true, Computed: true},
-							"prebuilt_report": {Type: schema.TypeString, Optional: true},
-							"report_type":     {Type: schema.TypeString, Optional: true, Computed: true},
-							"fields":          {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"dimensions":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"metrics":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"segments":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"filter":          {Type: schema.TypeString, Optional: true},
-						},
-					},
-				},
-				"custom_tables": {Type: schema.TypeSet, Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"table_name":               {Type: schema.TypeString, Optional: true},
-							"config_type":              {Type: schema.TypeString, Optional: true, Computed: true},
-							"fields":                   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"breakdowns":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"action_breakdowns":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
-							"aggregation":              {Type: schema.TypeString, Optional: true, Computed: true},
-							"action_report_time":       {Type: schema.TypeString, Optional: true, Computed: true},
-							"click_attribution_window": {Type: schema.TypeString, Optional: true, Computed: true},
-							"view_attribution_window":  {Type: schema.TypeString, Optional: true, Computed: true},
-							"prebuilt_report_name":     {Type: schema.TypeString, Optional: true},
-						},
-					},
-				},
-				"project_credentials": {Type: schema.TypeSet, Optional: true,
-					Elem: &schema.Resource{
-						Schema: map[string]*schema.Schema{
-							"project":    {Type: schema.TypeString, Optional: true},
-							"api_key":    {Type: schema.TypeString, Optional: true, Sensitive: true},
-							"secret_key": {Type: schema.TypeString, Optional: true, Sensitive: true},
-						},
-					},
-				},
+				"title":   {Type: schema.TypeString, Computed: true},
+				"status":  {Type: schema.TypeString, Computed: true},
+				"message": {Type: schema.TypeString, Computed: true},
 			},
 		},
 	}
 }
 
+func resourceConnectorSchemaConfig() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Optional: true, Computed: true, MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: resourceConnectorConfigSchema(),
+		},
+	}
+}
+
 func resourceConnectorSchemaAuth() *schema.Schema {
 	return &schema.Schema{Type: schema.TypeList, Optional: true, MaxItems: 1,
 		Elem: &schema.Resource{
@@ -417,14 +147,14 @@ func resourceConnectorSchemaAuth() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"client_id":       {Type: schema.TypeString, Optional: true},
-							"client_secret":   {Type: schema.TypeString, Optional: true, Sensitive: true},
+							"client_secret":   {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
 							"user_agent":      {Type: schema.TypeString, Optional: true},
 							"developer_token": {Type: schema.TypeString, Optional: true, Sensitive: true},
 						},
 					},
 				},
-				"refresh_token": {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"access_token":  {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"refresh_token": {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
+				"access_token":  {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
 				"realm_id":      {Type: schema.TypeString, Optional: true, Sensitive: true},
 			},
 		},
@@ -433,7 +163,7 @@ func resourceConnectorSchemaAuth() *schema.Schema {
 
 func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewConnectorCreate()
 
 	svc.GroupID(d.Get("group_id").(string))
@@ -445,38 +175,111 @@ func resourceConnectorCreate(ctx context.Context, d *schema.ResourceData, m inte
 	}
 
 	svc.Service(currentService)
-	svc.TrustCertificates(strToBool(d.Get("trust_certificates").(string)))
-	svc.TrustFingerprints(strToBool(d.Get("trust_fingerprints").(string)))
-	svc.RunSetupTests(strToBool(d.Get("run_setup_tests").(string)))
-	svc.Paused(strToBool(d.Get("paused").(string)))
-	svc.PauseAfterTrial(strToBool(d.Get("pause_after_trial").(string)))
-	svc.SyncFrequency(strToInt(d.Get("sync_frequency").(string)))
-	if d.Get("sync_frequency") == "1440" && d.Get("daily_sync_time").(string) != "" {
+	svc.TrustCertificates(d.Get("trust_certificates").(bool))
+	svc.TrustFingerprints(d.Get("trust_fingerprints").(bool))
+	svc.RunSetupTests(d.Get("run_setup_tests").(bool))
+	svc.Paused(d.Get("paused").(bool))
+	svc.PauseAfterTrial(d.Get("pause_after_trial").(bool))
+	svc.SyncFrequency(d.Get("sync_frequency").(int))
+	if d.Get("sync_frequency").(int) == 1440 && d.Get("daily_sync_time").(string) != "" {
 		svc.DailySyncTime(d.Get("daily_sync_time").(string))
 	}
 
-	fivetranConfig := resourceConnectorUpdateConfig(d)
-
-	svc.Config(resourceConnectorCreateConfig(fivetranConfig, d.Get("destination_schema").([]interface{})))
-	svc.ConfigCustom(resourceConnectorUpdateCustomConfig(d))
+	svc.ConfigCustom(resourceConnectorDestinationSchemaConfig(resourceConnectorUpdateCustomConfig(d), d.Get("destination_schema").([]interface{})))
 
 	svc.Auth(resourceConnectorCreateAuth(d.Get("auth").([]interface{})))
 	svc.AuthCustom(resourceConnectorUpdateCustomAuth(d))
 
+	// the invalid config values are rejected by resourceConnectorCustomizeDiff, only the warnings are left here
+	diags = append(diags, connectorConfigValidate(currentService, d.GetRawConfig().GetAttr("config"))...)
+
 	resp, err := svc.DoCustomMerged(ctx)
 	if err != nil {
 		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
 	}
 
+	setupTestsDiags := resourceConnectorSetupTestsDiags(resp.Data.SetupTests, d.Get("fail_on_setup_test_warning").(bool))
+	if setupTestsDiags.HasError() {
+		// the failed setup tests fail the create before the connector is added to the state, so it isn't tainted:
+		// the connector is deleted and the next apply creates it again
+		deleteResp, err := client.NewConnectorDelete().ConnectorID(resp.Data.ID).Do(ctx)
+		if err != nil {
+			// the connector that can't be deleted is kept in the state and tainted, the next apply replaces it
+			d.SetId(resp.Data.ID)
+			diags = append(diags, setupTestsDiags...)
+			return newDiagAppend(diags, diag.Error, "delete error", fmt.Sprintf("%v; code: %v; message: %v", err, deleteResp.Code, deleteResp.Message))
+		}
+		return append(diags, setupTestsDiags...)
+	}
+
 	d.SetId(resp.Data.ID)
+
+	if err := d.Set("setup_tests", resourceConnectorFlattenSetupTests(resp.Data.SetupTests)); err != nil {
+		return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+	}
+	diags = append(diags, setupTestsDiags...)
+
+	if d.Get("wait_for_setup").(bool) {
+		diags = append(diags, resourceConnectorWaitForSetupState(ctx, d, m)...)
+	}
+
 	resourceConnectorRead(ctx, d, m)
 
 	return diags
 }
 
+// resourceConnectorWaitForSetupState polls the connector details until its setup state reaches target_setup_state.
+// The wait fails when the setup is broken or the connector has tasks to resolve, e.g. after failed setup tests.
+func resourceConnectorWaitForSetupState(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient).Client
+	targetState := d.Get("target_setup_state").(string)
+	pollInterval, err := time.ParseDuration(d.Get("setup_poll_interval").(string))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "setup_poll_interval error", fmt.Sprint(err))
+	}
+
+	for {
+		resp, err := client.NewConnectorDetails().ConnectorID(d.Id()).DoCustomMerged(ctx)
+		if err != nil {
+			return newDiagAppend(diags, diag.Error, "wait for setup error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+		}
+
+		status := resp.Data.Status
+		if status.SetupState == targetState {
+			return diags
+		}
+		if status.SetupState == "broken" || len(status.Tasks) > 0 {
+			return newDiagAppend(diags, diag.Error, "connector setup failed",
+				fmt.Sprintf("setup_state: %v; expected: %v%v", status.SetupState, targetState, resourceConnectorStatusDetails(&resp)))
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return newDiagAppend(diags, diag.Error, "wait for setup error",
+				fmt.Sprintf("%v; setup_state: %v; expected: %v%v", ctx.Err(), status.SetupState, targetState, resourceConnectorStatusDetails(&resp)))
+		case <-timer.C:
+		}
+	}
+}
+
+// resourceConnectorStatusDetails lists the connector status tasks and warnings, one per line
+func resourceConnectorStatusDetails(resp *fivetran.ConnectorCustomMergedDetailsResponse) string {
+	var result strings.Builder
+	for _, task := range resp.Data.Status.Tasks {
+		result.WriteString(fmt.Sprintf("\ntask %v: %v", task.Code, task.Message))
+	}
+	for _, warning := range resp.Data.Status.Warnings {
+		result.WriteString(fmt.Sprintf("\nwarning %v: %v", warning.Code, warning.Message))
+	}
+	return result.String()
+}
+
 func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	resp, err := client.NewConnectorDetails().ConnectorID(d.Get("id").(string)).DoCustomMerged(ctx)
 	if err != nil {
@@ -511,22 +314,34 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, m interf
 	mapAddStr(msi, "created_at", resp.Data.CreatedAt.String())
 	mapAddStr(msi, "succeeded_at", resp.Data.SucceededAt.String())
 	mapAddStr(msi, "failed_at", resp.Data.FailedAt.String())
-	mapAddStr(msi, "sync_frequency", intPointerToStr(resp.Data.SyncFrequency))
+	mapAddIntP(msi, "sync_frequency", resp.Data.SyncFrequency)
 	if *resp.Data.SyncFrequency == 1440 {
 		mapAddStr(msi, "daily_sync_time", resp.Data.DailySyncTime)
 	} else {
 		mapAddStr(msi, "daily_sync_time", d.Get("daily_sync_time").(string))
 	}
 	mapAddStr(msi, "schedule_type", resp.Data.ScheduleType)
-	mapAddStr(msi, "paused", boolPointerToStr(resp.Data.Paused))
-	mapAddStr(msi, "pause_after_trial", boolPointerToStr(resp.Data.PauseAfterTrial))
+	mapAddBoolP(msi, "paused", resp.Data.Paused)
+	mapAddBoolP(msi, "pause_after_trial", resp.Data.PauseAfterTrial)
 	mapAddXInterface(msi, "status", resourceConnectorReadStatus(&resp))
 	currentConfig := d.Get("config").([]interface{})
-	upstreamConfig := resourceConnectorReadConfig(&resp, currentConfig)
+	upstreamConfig, err := resourceConnectorReadConfig(&resp, currentConfig)
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "read error", fmt.Sprint(err))
+	}
+	resourceConnectorReadConfigSkipJsonKeys(upstreamConfig, currentConfig, d.Get("config_json").(string))
 
 	if len(upstreamConfig) > 0 {
 		mapAddXInterface(msi, "config", upstreamConfig)
 	}
+	mapAddStr(msi, "config_json", resourceConnectorReadConfigJson(&resp, d.Get("config_json").(string)))
+	auth, err := resourceConnectorReadAuth(d.Get("auth").([]interface{}))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "read error", fmt.Sprint(err))
+	}
+	if len(auth) > 0 {
+		mapAddXInterface(msi, "auth", auth)
+	}
 
 	for k, v := range msi {
 		if err := d.Set(k, v); err != nil {
@@ -541,55 +356,68 @@ func resourceConnectorRead(ctx context.Context, d *schema.ResourceData, m interf
 
 func resourceConnectorUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewConnectorModify()
 
 	svc.ConnectorID(d.Get("id").(string))
 
 	if d.HasChange("sync_frequency") {
-		svc.SyncFrequency(strToInt(d.Get("sync_frequency").(string)))
+		svc.SyncFrequency(d.Get("sync_frequency").(int))
 	}
 	if d.HasChange("trust_certificates") {
-		svc.TrustCertificates(strToBool(d.Get("trust_certificates").(string)))
+		svc.TrustCertificates(d.Get("trust_certificates").(bool))
 	}
 	if d.HasChange("trust_fingerprints") {
-		svc.TrustFingerprints(strToBool(d.Get("trust_fingerprints").(string)))
+		svc.TrustFingerprints(d.Get("trust_fingerprints").(bool))
 	}
 	if d.HasChange("run_setup_tests") {
-		svc.RunSetupTests(strToBool(d.Get("run_setup_tests").(string)))
+		svc.RunSetupTests(d.Get("run_setup_tests").(bool))
 	}
 	if d.HasChange("paused") {
-		svc.Paused(strToBool(d.Get("paused").(string)))
+		svc.Paused(d.Get("paused").(bool))
 	}
 	if d.HasChange("pause_after_trial") {
-		svc.PauseAfterTrial(strToBool(d.Get("pause_after_trial").(string)))
+		svc.PauseAfterTrial(d.Get("pause_after_trial").(bool))
 	}
-	if d.Get("sync_frequency") == "1440" && d.HasChange("daily_sync_time") {
+	if d.Get("sync_frequency").(int) == 1440 && d.HasChange("daily_sync_time") {
 		svc.DailySyncTime(d.Get("daily_sync_time").(string))
 	}
 
-	svc.Config(resourceConnectorUpdateConfig(d))
-	svc.ConfigCustom(resourceConnectorUpdateCustomConfig(d))
+	configMap := resourceConnectorUpdateCustomConfig(d)
+	if d.HasChange("destination_schema") {
+		// only destinationSchemaRenameServices get here, resourceConnectorCustomizeDiff replaces the other connectors
+		configMap = resourceConnectorDestinationSchemaConfig(configMap, d.Get("destination_schema").([]interface{}))
+	}
+	svc.ConfigCustom(configMap)
 	svc.Auth(resourceConnectorCreateAuth(d.Get("auth").([]interface{})))
 	svc.AuthCustom(resourceConnectorUpdateCustomAuth(d))
 
+	if d.HasChange("config") {
+		// the invalid config values are rejected by resourceConnectorCustomizeDiff, only the warnings are left here
+		diags = append(diags, connectorConfigValidate(d.Get("service").(string), d.GetRawConfig().GetAttr("config"))...)
+	}
+
 	resp, err := svc.DoCustomMerged(ctx)
 	if err != nil {
 		// resourceConnectorRead here makes sure the state is updated after a NewConnectorModify error.
-		diags = resourceConnectorRead(ctx, d, m)
+		diags = append(diags, resourceConnectorRead(ctx, d, m)...)
 		return newDiagAppend(diags, diag.Error, "update error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
 	}
 
 	if err := d.Set("last_updated", time.Now().Format(time.RFC850)); err != nil {
 		return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
 	}
+	if err := d.Set("setup_tests", resourceConnectorFlattenSetupTests(resp.Data.SetupTests)); err != nil {
+		return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+	}
+	diags = append(diags, resourceConnectorSetupTestsDiags(resp.Data.SetupTests, d.Get("fail_on_setup_test_warning").(bool))...)
 
-	return resourceConnectorRead(ctx, d, m)
+	return append(diags, resourceConnectorRead(ctx, d, m)...)
 }
 
 func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewConnectorDelete()
 
 	resp, err := svc.ConnectorID(d.Get("id").(string)).Do(ctx)
@@ -603,156 +431,264 @@ func resourceConnectorDelete(ctx context.Context, d *schema.ResourceData, m inte
 }
 
 func resourceConnectorUpdateCustomConfig(d *schema.ResourceData) *map[string]interface{} {
-	configMap := make(map[string]interface{})
+	// config_json is the base of the custom config, resourceConnectorCustomizeDiff makes sure
+	// none of its keys is also set in the config block
+	configMap := resourceConnectorExpandConfigJson(d.Get("config_json").(string))
 
 	var config = d.Get("config").([]interface{})
 
-	if len(config) < 1 {
-		return &configMap
-	}
-	if config[0] == nil {
+	if len(config) < 1 || config[0] == nil {
 		return &configMap
 	}
 
-	c := config[0].(map[string]interface{})
-
-	if v, ok := c["group_name"].(string); ok && v != "" {
-		configMap["group_name"] = v
-	}
-
-	if v, ok := c["sync_method"].(string); ok && v != "" {
-		configMap["sync_method"] = v
+	raw := connectorConfigRawBlock(d.GetRawConfig().GetAttr("config"))
+	for k, v := range resourceConnectorExpandConfig(config[0].(map[string]interface{}), raw) {
+		configMap[k] = v
 	}
-
-	if v, ok := c["is_account_level_connector"].(string); ok && v != "" {
-		configMap["is_account_level_connector"] = strToBool(v)
+	// the unchanged secrets keep their salted hashes, see secretDiffSuppressFunc, they are already set upstream
+	for _, k := range resourceConnectorConfigSecretFields {
+		if v, ok := configMap[k].(string); ok && !secretIsPlain(v) {
+			delete(configMap, k)
+		}
 	}
 
-	// HVA connector parameters
+	return &configMap
+}
 
-	if v, ok := c["pdb_name"].(string); ok && v != "" {
-		configMap["pdb_name"] = v
+// resourceConnectorCustomizeDiff validates the config block against the connectorConfigServices catalog of the
+// service, so the invalid values are reported by the plan instead of the create/update request.
+func resourceConnectorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.NewValueKnown("service") {
+		diags := connectorConfigValidate(d.Get("service").(string), d.GetRawConfig().GetAttr("config"))
+		if err := connectorConfigValidateError(diags); err != nil {
+			return err
+		}
 	}
 
-	if v, ok := c["agent_host"].(string); ok && v != "" {
-		configMap["agent_host"] = v
+	if err := resourceConnectorCustomizeDiffDestinationSchema(d); err != nil {
+		return err
 	}
 
-	if v, ok := c["agent_port"].(string); ok && v != "" {
-		configMap["agent_port"] = strToInt(v)
-	}
+	return resourceConnectorCustomizeDiffConfigJson(d)
+}
 
-	if v, ok := c["agent_user"].(string); ok && v != "" {
-		configMap["agent_user"] = v
+// resourceConnectorImport imports a connector by its ID, or by `<group_id>/<schema_name>` or
+// `<group_name>/<schema_name>`.
+func resourceConnectorImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if i := strings.LastIndex(d.Id(), "/"); i >= 0 {
+		id, err := resourceConnectorImportResolveID(ctx, m.(*ProviderClient).Client, d.Id()[:i], d.Id()[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		d.SetId(id)
 	}
 
-	if v, ok := c["agent_password"].(string); ok && v != "" {
-		configMap["agent_password"] = v
+	if err := resourceConnectorImportDefaults(d); err != nil {
+		return nil, err
 	}
+	return []*schema.ResourceData{d}, nil
+}
 
-	if v, ok := c["agent_public_cert"].(string); ok && v != "" {
-		configMap["agent_public_cert"] = v
+// resourceConnectorImportDefaults sets the fields with default values, such as wait_for_setup, to their defaults.
+// The REST API doesn't return them, so without the defaults the plan after the import would show them as changed.
+func resourceConnectorImportDefaults(d *schema.ResourceData) error {
+	for k, v := range resourceConnectorSchema() {
+		if v.Default == nil {
+			continue
+		}
+		if err := d.Set(k, v.Default); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	if v, ok := c["agent_ora_home"].(string); ok && v != "" {
-		configMap["agent_ora_home"] = v
+// resourceConnectorImportResolveID returns the ID of the connector with the schema name in the group, the group is
+// matched by its ID first and then by its name
+func resourceConnectorImportResolveID(ctx context.Context, client *fivetran.Client, group, schemaName string) (string, error) {
+	groups, err := dataSourceGroupsGetGroups(client, ctx)
+	if err != nil {
+		return "", fmt.Errorf("%v; code: %v; message: %v", err, groups.Code, groups.Message)
 	}
 
-	if v, ok := c["tns"].(string); ok && v != "" {
-		configMap["tns"] = v
+	var groupIDs, groupNames []string
+	for _, item := range groups.Data.Items {
+		if item.ID == group {
+			groupIDs = []string{item.ID}
+			break
+		}
+		if item.Name == group {
+			groupIDs = append(groupIDs, item.ID)
+		}
+		groupNames = append(groupNames, item.Name)
 	}
-
-	if v, ok := c["use_oracle_rac"].(string); ok && v != "" {
-		configMap["use_oracle_rac"] = strToBool(v)
+	switch {
+	case len(groupIDs) == 0:
+		return "", fmt.Errorf("group %q not found%v", group, nearMatchesHint(group, groupNames))
+	case len(groupIDs) > 1:
+		return "", fmt.Errorf("group name %q is ambiguous, use one of the group IDs instead: %v", group, strings.Join(groupIDs, ", "))
 	}
 
-	if v, ok := c["asm_option"].(string); ok && v != "" {
-		configMap["asm_option"] = strToBool(v)
+	connectors, err := dataSourceGroupConnectorsGetConnectors(client, groupIDs[0], "", ctx)
+	if err != nil {
+		return "", fmt.Errorf("%v; code: %v; message: %v", err, connectors.Code, connectors.Message)
 	}
 
-	if v, ok := c["asm_user"].(string); ok && v != "" {
-		configMap["asm_user"] = v
+	var schemaNames []string
+	for _, item := range connectors.Data.Items {
+		if item.Schema == schemaName {
+			return item.ID, nil
+		}
+		schemaNames = append(schemaNames, item.Schema)
 	}
+	return "", fmt.Errorf("connector with schema name %q not found in group %v%v", schemaName, groupIDs[0], nearMatchesHint(schemaName, schemaNames))
+}
 
-	if v, ok := c["asm_password"].(string); ok && v != "" {
-		configMap["asm_password"] = v
+// nearMatchesHint returns the nearMatches of value as an error message suffix
+func nearMatchesHint(value string, candidates []string) string {
+	matches := nearMatches(value, candidates)
+	if len(matches) == 0 {
+		return ""
 	}
+	return fmt.Sprintf(", did you mean: %v?", strings.Join(matches, ", "))
+}
 
-	if v, ok := c["asm_oracle_home"].(string); ok && v != "" {
-		configMap["asm_oracle_home"] = v
+// resourceConnectorCustomizeDiffDestinationSchema requires the replacement of the connector when its destination
+// schema changes, only the name and table of destinationSchemaRenameServices are renamed in place.
+func resourceConnectorCustomizeDiffDestinationSchema(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
 	}
 
-	if v, ok := c["asm_tns"].(string); ok && v != "" {
-		configMap["asm_tns"] = v
+	service := d.Get("service").(string)
+	for _, k := range []string{"name", "table", "prefix"} {
+		key := "destination_schema.0." + k
+		if !d.HasChange(key) || (k != "prefix" && destinationSchemaRenameServices[service]) {
+			continue
+		}
+		if err := d.ForceNew(key); err != nil {
+			return err
+		}
 	}
 
-	if v, ok := c["sap_user"].(string); ok && v != "" {
-		configMap["sap_user"] = v
-	}
+	return nil
+}
 
-	if v, ok := c["organization"].(string); ok && v != "" {
-		configMap["organization"] = v
+// resourceConnectorCustomizeDiffConfigJson rejects config_json keys that are also set in the config block,
+// the config block would silently override them in the request.
+func resourceConnectorCustomizeDiffConfigJson(d *schema.ResourceDiff) error {
+	if !d.NewValueKnown("config_json") {
+		return nil
 	}
-
-	if v, ok := c["packed_mode_tables"]; ok {
-		configMap["packed_mode_tables"] = xInterfaceStrXStr(v.(*schema.Set).List())
+	configJson := resourceConnectorExpandConfigJson(d.Get("config_json").(string))
+	if len(configJson) == 0 {
+		return nil
 	}
 
-	if v, ok := c["access_key"].(string); ok && v != "" {
-		configMap["access_key"] = v
+	config := d.GetRawConfig().GetAttr("config")
+	if !config.IsKnown() || config.IsNull() || config.LengthInt() == 0 {
+		return nil
 	}
-
-	if v, ok := c["domain_host_name"].(string); ok && v != "" {
-		configMap["domain_host_name"] = v
+	block := config.Index(cty.NumberIntVal(0))
+	if !block.IsKnown() || block.IsNull() {
+		return nil
 	}
 
-	if v, ok := c["client_name"].(string); ok && v != "" {
-		configMap["client_name"] = v
+	var conflicts []string
+	for k, v := range block.AsValueMap() {
+		if _, ok := configJson[k]; ok && !v.IsNull() {
+			conflicts = append(conflicts, k)
+		}
 	}
-
-	if v, ok := c["domain_type"].(string); ok && v != "" {
-		configMap["domain_type"] = v
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return fmt.Errorf("config keys set in both config and config_json: %v; each key can be set in only one of them", strings.Join(conflicts, ", "))
 	}
 
-	if v, ok := c["connection_method"].(string); ok && v != "" {
-		configMap["connection_method"] = v
-	}
+	return nil
+}
 
-	if v, ok := c["is_single_table_mode"].(string); ok && v != "" {
-		configMap["is_single_table_mode"] = strToBool(v)
+func resourceConnectorConfigJsonValidateFunc(val interface{}, key string) (warns []string, errs []error) {
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(val.(string)), &v); err != nil {
+		errs = append(errs, fmt.Errorf("%q expected a JSON object: %v", key, err))
 	}
+	return
+}
 
-	if v, ok := c["company_id"].(string); ok && v != "" {
-		configMap["company_id"] = v
+// resourceConnectorConfigJsonDiffSuppressFunc ignores formatting and key order changes of config_json
+func resourceConnectorConfigJsonDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue map[string]interface{}
+	if json.Unmarshal([]byte(old), &oldValue) != nil || json.Unmarshal([]byte(new), &newValue) != nil {
+		return false
 	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
 
-	if v, ok := c["login_password"].(string); ok && v != "" {
-		configMap["login_password"] = v
+// resourceConnectorExpandConfigJson returns the config_json keys, the value is validated by
+// resourceConnectorConfigJsonValidateFunc so an empty map is only returned for an empty value.
+func resourceConnectorExpandConfigJson(configJson string) map[string]interface{} {
+	result := make(map[string]interface{})
+	if configJson != "" {
+		json.Unmarshal([]byte(configJson), &result)
 	}
+	return result
+}
 
-	if v, ok := c["environment"].(string); ok && v != "" {
-		configMap["environment"] = v
+// resourceConnectorReadConfigSkipJsonKeys keeps the current config block values of the keys managed by
+// config_json, so the upstream values of these keys don't drift the config block.
+func resourceConnectorReadConfigSkipJsonKeys(upstreamConfig, currentConfig []interface{}, configJson string) {
+	if len(upstreamConfig) == 0 || upstreamConfig[0] == nil {
+		return
 	}
-
-	if v, ok := c["properties"]; ok {
-		configMap["properties"] = xInterfaceStrXStr(v.(*schema.Set).List())
+	upstream := upstreamConfig[0].(map[string]interface{})
+	var current map[string]interface{}
+	if len(currentConfig) > 0 && currentConfig[0] != nil {
+		current = currentConfig[0].(map[string]interface{})
 	}
-
-	if v, ok := c["is_public"].(string); ok && v != "" {
-		configMap["is_public"] = strToBool(v)
+	for k := range resourceConnectorExpandConfigJson(configJson) {
+		if _, ok := upstream[k]; !ok {
+			continue
+		}
+		if v, ok := current[k]; ok {
+			upstream[k] = v
+		} else {
+			delete(upstream, k)
+		}
 	}
+}
 
-	if v, ok := c["empty_header"].(string); ok && v != "" {
-		configMap["empty_header"] = strToBool(v)
+// resourceConnectorReadConfigJson returns config_json with the upstream values of the keys set in the
+// currentConfigJson only, the rest of the connector config is left to the config block.
+func resourceConnectorReadConfigJson(resp *fivetran.ConnectorCustomMergedDetailsResponse, currentConfigJson string) string {
+	current := resourceConnectorExpandConfigJson(currentConfigJson)
+	if len(current) == 0 {
+		return ""
 	}
 
-	if v, ok := c["list_strategy"].(string); ok && v != "" {
-		configMap["list_strategy"] = v
-	}
+	upstream := connectorConfigUpstream(resp)
 
-	// HVA parameters end
+	result := make(map[string]interface{})
+	for k, v := range current {
+		upstreamValue, ok := upstream[k]
+		if !ok || upstreamValue == nil {
+			continue
+		}
+		// Fivetran returns sensitive values masked, and may return a value in a different type,
+		// e.g. a port number as a string, in both cases the current value is kept to prevent drifting
+		if s, ok := upstreamValue.(string); ok && s != "" && strings.Trim(s, "*") == "" {
+			upstreamValue = v
+		} else if fmt.Sprint(upstreamValue) == fmt.Sprint(v) {
+			upstreamValue = v
+		}
+		result[k] = upstreamValue
+	}
 
-	return &configMap
+	configJson, err := json.Marshal(result)
+	if err != nil {
+		return currentConfigJson
+	}
+	return string(configJson)
 }
 
 func resourceConnectorUpdateCustomAuth(d *schema.ResourceData) *map[string]interface{} {
@@ -778,1392 +714,234 @@ func resourceConnectorUpdateCustomAuth(d *schema.ResourceData) *map[string]inter
 	return &authMap
 }
 
-func resourceConnectorUpdateConfig(d *schema.ResourceData) *fivetran.ConnectorConfig {
-	fivetranConfig := fivetran.NewConnectorConfig()
-	var config = d.Get("config").([]interface{})
-
-	if len(config) < 1 {
-		return fivetranConfig
+// resourceConnectorDestinationSchemaConfig adds the destination schema fields to the request config
+func resourceConnectorDestinationSchemaConfig(configMap *map[string]interface{}, destination_schema []interface{}) *map[string]interface{} {
+	d := destination_schema[0].(map[string]interface{})
+	if v := d["name"].(string); v != "" {
+		(*configMap)["schema"] = v
+	}
+	if v := d["table"].(string); v != "" {
+		(*configMap)["table"] = v
 	}
-	if config[0] == nil {
-		return fivetranConfig
+	if v := d["prefix"].(string); v != "" {
+		(*configMap)["schema_prefix"] = v
 	}
 
-	c := config[0].(map[string]interface{})
+	return configMap
+}
+
+// resourceConnectorCreateAuth returns the auth of the request, the unchanged secrets keep their salted hashes, see
+// secretDiffSuppressFunc, they are already set upstream and aren't sent
+func resourceConnectorCreateAuth(auth []interface{}) *fivetran.ConnectorAuth {
+	fivetranAuth := fivetran.NewConnectorAuth()
 
-	if v := c["sheet_id"].(string); v != "" {
-		fivetranConfig.SheetID(v)
+	if len(auth) < 1 {
+		return fivetranAuth
 	}
-	if v := c["share_url"].(string); v != "" {
-		fivetranConfig.ShareURL(v)
+	if auth[0] == nil {
+		return fivetranAuth
 	}
-	if v := c["named_range"].(string); v != "" {
-		fivetranConfig.NamedRange(v)
+
+	a := auth[0].(map[string]interface{})
+
+	if v := a["client_access"].([]interface{}); len(v) > 0 {
+		fivetranAuth.ClientAccess(resourceConnectorCreateAuthClientAccess(v))
 	}
-	if v := c["client_id"].(string); v != "" {
-		fivetranConfig.ClientID(v)
+	if v := a["refresh_token"].(string); v != "" && secretIsPlain(v) {
+		fivetranAuth.RefreshToken(v)
 	}
-	if v := c["client_secret"].(string); v != "" {
-		fivetranConfig.ClientSecret(v)
+	if v := a["access_token"].(string); v != "" && secretIsPlain(v) {
+		fivetranAuth.AccessToken(v)
 	}
-	if v := c["technical_account_id"].(string); v != "" {
-		fivetranConfig.TechnicalAccountID(v)
+	if v := a["realm_id"].(string); v != "" {
+		fivetranAuth.RealmID(v)
 	}
-	if v := c["organization_id"].(string); v != "" {
-		fivetranConfig.OrganizationID(v)
+
+	return fivetranAuth
+}
+
+func resourceConnectorCreateAuthClientAccess(clientAccess []interface{}) *fivetran.ConnectorAuthClientAccess {
+	fivetranAuthClientAccess := fivetran.NewConnectorAuthClientAccess()
+
+	if len(clientAccess) < 1 {
+		return fivetranAuthClientAccess
 	}
-	if v := c["private_key"].(string); v != "" {
-		fivetranConfig.PrivateKey(v)
+	if clientAccess[0] == nil {
+		return fivetranAuthClientAccess
 	}
-	if v := c["sync_mode"].(string); v != "" {
-		fivetranConfig.SyncMode(v)
+
+	ca := clientAccess[0].(map[string]interface{})
+	if v := ca["client_id"].(string); v != "" {
+		fivetranAuthClientAccess.ClientID(v)
 	}
-	if v := c["report_suites"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.ReportSuites(xInterfaceStrXStr(v))
+	if v := ca["client_secret"].(string); v != "" && secretIsPlain(v) {
+		fivetranAuthClientAccess.ClientSecret(v)
 	}
-	if v := c["elements"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Elements(xInterfaceStrXStr(v))
+	if v := ca["user_agent"].(string); v != "" {
+		fivetranAuthClientAccess.UserAgent(v)
 	}
-	if v := c["metrics"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Metrics(xInterfaceStrXStr(v))
+	if v := ca["developer_token"].(string); v != "" {
+		fivetranAuthClientAccess.DeveloperToken(v)
 	}
-	if v := c["date_granularity"].(string); v != "" {
-		fivetranConfig.DateGranularity(v)
+
+	return fivetranAuthClientAccess
+}
+
+// resourceConnectorReadStatus receives a *fivetran.ConnectorDetailsResponse and returns a []interface{}
+// containing the data type accepted by the "status" list.
+func resourceConnectorReadStatus(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
+	status := make([]interface{}, 1)
+
+	s := make(map[string]interface{})
+	mapAddStr(s, "setup_state", resp.Data.Status.SetupState)
+	mapAddStr(s, "sync_state", resp.Data.Status.SyncState)
+	mapAddStr(s, "update_state", resp.Data.Status.UpdateState)
+	mapAddStr(s, "is_historical_sync", boolPointerToStr(resp.Data.Status.IsHistoricalSync))
+	mapAddXInterface(s, "tasks", resourceConnectorReadStatusFlattenTasks(resp))
+	mapAddXInterface(s, "warnings", resourceConnectorReadStatusFlattenWarnings(resp))
+	status[0] = s
+
+	return status
+}
+
+// resourceConnectorSetupTest is a setup test result of the create and modify responses
+type resourceConnectorSetupTest = struct {
+	Title   string `json:"title"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// resourceConnectorFlattenSetupTests returns the setup tests results of the create and modify responses in the
+// data type accepted by the "setup_tests" list, the details response doesn't have them
+func resourceConnectorFlattenSetupTests(tests []resourceConnectorSetupTest) []interface{} {
+	result := make([]interface{}, len(tests))
+	for i, v := range tests {
+		test := make(map[string]interface{})
+		mapAddStr(test, "title", v.Title)
+		mapAddStr(test, "status", v.Status)
+		mapAddStr(test, "message", v.Message)
+
+		result[i] = test
 	}
-	if v := c["timeframe_months"].(string); v != "" {
-		fivetranConfig.TimeframeMonths(v)
+	return result
+}
+
+// resourceConnectorSetupTestsDiags reports the setup tests that didn't pass or weren't skipped as warnings, or as
+// errors when failOnWarning is set
+func resourceConnectorSetupTestsDiags(tests []resourceConnectorSetupTest, failOnWarning bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	severity := diag.Warning
+	if failOnWarning {
+		severity = diag.Error
 	}
-	if v := c["source"].(string); v != "" {
-		fivetranConfig.Source(v)
+	for _, v := range tests {
+		if v.Status == "PASSED" || v.Status == "SKIPPED" {
+			continue
+		}
+		diags = newDiagAppend(diags, severity, "setup test "+strings.ToLower(v.Status), fmt.Sprintf("%v: %v", v.Title, v.Message))
 	}
-	if v := c["s3bucket"].(string); v != "" {
-		fivetranConfig.S3Bucket(v)
+	return diags
+}
+
+func resourceConnectorReadStatusFlattenTasks(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
+	if len(resp.Data.Status.Tasks) < 1 {
+		return make([]interface{}, 0)
 	}
-	if v := c["s3role_arn"].(string); v != "" {
-		fivetranConfig.S3RoleArn(v)
+
+	tasks := make([]interface{}, len(resp.Data.Status.Tasks))
+	for i, v := range resp.Data.Status.Tasks {
+		task := make(map[string]interface{})
+		mapAddStr(task, "code", v.Code)
+		mapAddStr(task, "message", v.Message)
+
+		tasks[i] = task
 	}
-	if v := c["abs_connection_string"].(string); v != "" {
-		fivetranConfig.ABSConnectionString(v)
+
+	return tasks
+}
+
+func resourceConnectorReadStatusFlattenWarnings(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
+	if len(resp.Data.Status.Warnings) < 1 {
+		return make([]interface{}, 0)
 	}
-	if v := c["abs_container_name"].(string); v != "" {
-		fivetranConfig.ABSContainerName(v)
+
+	warnings := make([]interface{}, len(resp.Data.Status.Warnings))
+	for i, v := range resp.Data.Status.Warnings {
+		warning := make(map[string]interface{})
+		mapAddStr(warning, "code", v.Code)
+		mapAddStr(warning, "message", v.Message)
+
+		warnings[i] = warning
 	}
-	if v := c["folder_id"].(string); v != "" {
-		fivetranConfig.FolderId(v)
+
+	return warnings
+}
+
+// resourceConnectorReadConfig receives a *fivetran.ConnectorCustomMergedDetailsResponse and returns a []interface{}
+// containing the data type accepted by the "config" list.
+func resourceConnectorReadConfig(resp *fivetran.ConnectorCustomMergedDetailsResponse, currentConfig []interface{}) ([]interface{}, error) {
+	upstream := connectorConfigUpstream(resp)
+	c := resourceConnectorFlattenConfig(upstream, currentConfig)
+	if err := resourceConnectorReadConfigSecrets(c, upstream); err != nil {
+		return nil, err
 	}
-	if v := c["ftp_host"].(string); v != "" {
-		fivetranConfig.FTPHost(v)
+
+	config := make([]interface{}, 1)
+	config[0] = c
+
+	return config, nil
+}
+
+// resourceConnectorReadConfigSecrets replaces the secrets of the config block c with their salted hashes. Fivetran
+// returns the secrets masked, an empty upstream value means the secret was cleared outside of Terraform, so it's
+// cleared in the state too and the plan sends it again. A secret changed outside of Terraform is still masked and
+// can't be detected, see secrets_version.
+func resourceConnectorReadConfigSecrets(c, upstream map[string]interface{}) error {
+	for _, k := range resourceConnectorConfigSecretFields {
+		v, ok := c[k].(string)
+		if !ok {
+			continue
+		}
+		if u, ok := upstream[k]; ok && connectorConfigValueStr(u) == "" {
+			c[k] = ""
+			continue
+		}
+		h, err := secretStateValue(v)
+		if err != nil {
+			return err
+		}
+		c[k] = h
 	}
-	if v := c["ftp_port"].(string); v != "" {
-		fivetranConfig.FTPPort(strToInt(v))
+	return nil
+}
+
+// resourceConnectorReadAuth returns the auth block of the state with the salted hashes of its secrets, the auth
+// block isn't returned by the REST API.
+func resourceConnectorReadAuth(auth []interface{}) ([]interface{}, error) {
+	if len(auth) < 1 || auth[0] == nil {
+		return nil, nil
 	}
-	if v := c["ftp_user"].(string); v != "" {
-		fivetranConfig.FTPUser(v)
+	a := copyMap(auth[0].(map[string]interface{}))
+	if err := resourceConnectorReadAuthSecrets(a, "refresh_token", "access_token"); err != nil {
+		return nil, err
 	}
-	if v := c["ftp_password"].(string); v != "" {
-		fivetranConfig.FTPPassword(v)
+	if clientAccess, ok := a["client_access"].([]interface{}); ok && len(clientAccess) > 0 && clientAccess[0] != nil {
+		ca := copyMap(clientAccess[0].(map[string]interface{}))
+		if err := resourceConnectorReadAuthSecrets(ca, "client_secret"); err != nil {
+			return nil, err
+		}
+		a["client_access"] = []interface{}{ca}
 	}
-	if v := c["is_ftps"].(string); v != "" {
-		fivetranConfig.IsFTPS(strToBool(v))
-	}
-	if v := c["sftp_host"].(string); v != "" {
-		fivetranConfig.SFTPHost(v)
-	}
-	if v := c["sftp_port"].(string); v != "" {
-		fivetranConfig.SFTPPort(strToInt(v))
-	}
-	if v := c["sftp_user"].(string); v != "" {
-		fivetranConfig.SFTPUser(v)
-	}
-	if v := c["sftp_password"].(string); v != "" {
-		fivetranConfig.SFTPPassword(v)
-	}
-	if v := c["sftp_is_key_pair"].(string); v != "" {
-		fivetranConfig.SFTPIsKeyPair(strToBool(v))
-	}
-	if v := c["is_keypair"].(string); v != "" {
-		fivetranConfig.IsKeypair(strToBool(v))
-	}
-	if v := c["advertisables"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Advertisables(xInterfaceStrXStr(v))
-	}
-	if v := c["report_type"].(string); v != "" {
-		fivetranConfig.ReportType(v)
-	}
-	if v := c["dimensions"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Dimensions(xInterfaceStrXStr(v))
-	}
-	if v := c["api_key"].(string); v != "" {
-		fivetranConfig.APIKey(v)
-	}
-	if v := c["external_id"].(string); v != "" {
-		fivetranConfig.ExternalID(v)
-	}
-	if v := c["role_arn"].(string); v != "" {
-		fivetranConfig.RoleArn(v)
-	}
-	if v := c["bucket"].(string); v != "" {
-		fivetranConfig.Bucket(v)
-	}
-	if v := c["prefix"].(string); v != "" {
-		fivetranConfig.Prefix(v)
-	}
-	if v := c["pattern"].(string); v != "" {
-		fivetranConfig.Pattern(v)
-	}
-	if v := c["file_type"].(string); v != "" {
-		fivetranConfig.FileType(v)
-	}
-	if v := c["compression"].(string); v != "" {
-		fivetranConfig.Compression(v)
-	}
-	if v := c["on_error"].(string); v != "" {
-		fivetranConfig.OnError(v)
-	}
-	if v := c["append_file_option"].(string); v != "" {
-		fivetranConfig.AppendFileOption(v)
-	}
-	if v := c["archive_pattern"].(string); v != "" {
-		fivetranConfig.ArchivePattern(v)
-	}
-	if v := c["null_sequence"].(string); v != "" {
-		fivetranConfig.NullSequence(v)
-	}
-	if v := c["delimiter"].(string); v != "" {
-		fivetranConfig.Delimiter(v)
-	}
-	if v := c["escape_char"].(string); v != "" {
-		fivetranConfig.EscapeChar(v)
-	}
-	if v := c["skip_before"].(string); v != "" {
-		fivetranConfig.SkipBefore(strToInt(v))
-	}
-	if v := c["skip_after"].(string); v != "" {
-		fivetranConfig.SkipAfter(strToInt(v))
-	}
-	if v := c["project_credentials"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.ProjectCredentials(resourceConnectorCreateConfigProjectCredentials(v))
-	}
-	if v := c["secrets_list"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.SecretsList(resourceConnectorCreateFunctionSecrets(v))
-	}
-	if v := c["auth_mode"].(string); v != "" {
-		fivetranConfig.AuthMode(v)
-	}
-	if v := c["username"].(string); v != "" {
-		fivetranConfig.Username(v)
-	}
-	if v := c["user_name"].(string); v != "" {
-		fivetranConfig.UserName(v)
-	}
-	if v := c["password"].(string); v != "" {
-		fivetranConfig.Password(v)
-	}
-	if v := c["certificate"].(string); v != "" {
-		fivetranConfig.Certificate(v)
-	}
-	if v := c["selected_exports"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.SelectedExports(xInterfaceStrXStr(v))
-	}
-	if v := c["consumer_group"].(string); v != "" {
-		fivetranConfig.ConsumerGroup(v)
-	}
-	if v := c["servers"].(string); v != "" {
-		fivetranConfig.Servers(v)
-	}
-	if v := c["message_type"].(string); v != "" {
-		fivetranConfig.MessageType(v)
-	}
-	if v := c["sync_type"].(string); v != "" {
-		fivetranConfig.SyncType(v)
-	}
-	if v := c["security_protocol"].(string); v != "" {
-		fivetranConfig.SecurityProtocol(v)
-	}
-	if v := c["apps"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Apps(xInterfaceStrXStr(v))
-	}
-	if v := c["sales_accounts"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.SalesAccounts(xInterfaceStrXStr(v))
-	}
-	if v := c["finance_accounts"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.FinanceAccounts(xInterfaceStrXStr(v))
-	}
-	if v := c["app_sync_mode"].(string); v != "" {
-		fivetranConfig.AppSyncMode(v)
-	}
-	if v := c["sales_account_sync_mode"].(string); v != "" {
-		fivetranConfig.SalesAccountSyncMode(v)
-	}
-	if v := c["finance_account_sync_mode"].(string); v != "" {
-		fivetranConfig.FinanceAccountSyncMode(v)
-	}
-	if v := c["pem_certificate"].(string); v != "" {
-		fivetranConfig.PEMCertificate(v)
-	}
-	if v := c["access_key_id"].(string); v != "" {
-		fivetranConfig.AccessKeyID(v)
-	}
-	if v := c["secret_key"].(string); v != "" {
-		fivetranConfig.SecretKey(v)
-	}
-	if v := c["home_folder"].(string); v != "" {
-		fivetranConfig.HomeFolder(v)
-	}
-	if v := c["sync_data_locker"].(string); v != "" {
-		fivetranConfig.SyncDataLocker(strToBool(v))
-	}
-	if v := c["projects"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Projects(xInterfaceStrXStr(v))
-	}
-	if v := c["function"].(string); v != "" {
-		fivetranConfig.Function(v)
-	}
-	if v := c["region"].(string); v != "" {
-		fivetranConfig.Region(v)
-	}
-	if v := c["secrets"].(string); v != "" {
-		fivetranConfig.Secrets(v)
-	}
-	if v := c["container_name"].(string); v != "" {
-		fivetranConfig.ContainerName(v)
-	}
-	if v := c["connection_string"].(string); v != "" {
-		fivetranConfig.ConnectionString(v)
-	}
-	if v := c["function_app"].(string); v != "" {
-		fivetranConfig.FunctionApp(v)
-	}
-	if v := c["function_name"].(string); v != "" {
-		fivetranConfig.FunctionName(v)
-	}
-	if v := c["function_key"].(string); v != "" {
-		fivetranConfig.FunctionKey(v)
-	}
-	if v := c["merchant_id"].(string); v != "" {
-		fivetranConfig.MerchantID(v)
-	}
-	if v := c["api_url"].(string); v != "" {
-		fivetranConfig.APIURL(v)
-	}
-	if v := c["cloud_storage_type"].(string); v != "" {
-		fivetranConfig.CloudStorageType(v)
-	}
-	if v := c["s3external_id"].(string); v != "" {
-		fivetranConfig.S3ExternalID(v)
-	}
-	if v := c["s3folder"].(string); v != "" {
-		fivetranConfig.S3Folder(v)
-	}
-	if v := c["gcs_bucket"].(string); v != "" {
-		fivetranConfig.GCSBucket(v)
-	}
-	if v := c["gcs_folder"].(string); v != "" {
-		fivetranConfig.GCSFolder(v)
-	}
-	if v := c["user_profiles"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.UserProfiles(xInterfaceStrXStr(v))
-	}
-	if v := c["report_configuration_ids"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.ReportConfigurationIDs(xInterfaceStrXStr(v))
-	}
-	if v := c["enable_all_dimension_combinations"].(string); v != "" {
-		fivetranConfig.EnableAllDimensionCombinations(strToBool(v))
-	}
-	if v := c["instance"].(string); v != "" {
-		fivetranConfig.Instance(v)
-	}
-	if v := c["aws_region_code"].(string); v != "" {
-		fivetranConfig.AWSRegionCode(v)
-	}
-	if v := c["accounts"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Accounts(xInterfaceStrXStr(v))
-	}
-	if v := c["fields"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Fields(xInterfaceStrXStr(v))
-	}
-	if v := c["breakdowns"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Breakdowns(xInterfaceStrXStr(v))
-	}
-	if v := c["action_breakdowns"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.ActionBreakdowns(xInterfaceStrXStr(v))
-	}
-	if v := c["aggregation"].(string); v != "" {
-		fivetranConfig.Aggregation(v)
-	}
-	if v := c["config_type"].(string); v != "" {
-		fivetranConfig.ConfigType(v)
-	}
-	if v := c["prebuilt_report"].(string); v != "" {
-		fivetranConfig.PrebuiltReport(v)
-	}
-	if v := c["action_report_time"].(string); v != "" {
-		fivetranConfig.ActionReportTime(v)
-	}
-	if v := c["click_attribution_window"].(string); v != "" {
-		fivetranConfig.ClickAttributionWindow(v)
-	}
-	if v := c["view_attribution_window"].(string); v != "" {
-		fivetranConfig.ViewAttributionWindow(v)
-	}
-	if v := c["custom_tables"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.CustomTables(resourceConnectorCreateConfigCustomTables(v))
-	}
-	if v := c["pages"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Pages(xInterfaceStrXStr(v))
-	}
-	if v := c["subdomain"].(string); v != "" {
-		fivetranConfig.Subdomain(v)
-	}
-	if v := c["port"].(string); v != "" {
-		fivetranConfig.Port(strToInt(v))
-	}
-	if v := c["user"].(string); v != "" {
-		fivetranConfig.User(v)
-	}
-	if v := c["is_secure"].(string); v != "" {
-		fivetranConfig.IsSecure(strToBool(v))
-	}
-	if v := c["repositories"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Repositories(xInterfaceStrXStr(v))
-	}
-	if v := c["use_webhooks"].(string); v != "" {
-		fivetranConfig.UseWebhooks(strToBool(v))
-	}
-	if v := c["dimension_attributes"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.DimensionAttributes(xInterfaceStrXStr(v))
-	}
-	if v := c["columns"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Columns(xInterfaceStrXStr(v))
-	}
-	if v := c["network_code"].(string); v != "" {
-		fivetranConfig.NetworkCode(v)
-	}
-	if v := c["customer_id"].(string); v != "" {
-		fivetranConfig.CustomerID(v)
-	}
-	if v := c["manager_accounts"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.ManagerAccounts(xInterfaceStrXStr(v))
-	}
-	if v := c["reports"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Reports(resourceConnectorCreateConfigReports(v))
-	}
-	if v := c["conversion_window_size"].(string); v != "" {
-		fivetranConfig.ConversionWindowSize(strToInt(v))
-	}
-	if v := c["profiles"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Profiles(xInterfaceStrXStr(v))
-	}
-	if v := c["project_id"].(string); v != "" {
-		fivetranConfig.ProjectID(v)
-	}
-	if v := c["dataset_id"].(string); v != "" {
-		fivetranConfig.DatasetID(v)
-	}
-	if v := c["bucket_name"].(string); v != "" {
-		fivetranConfig.BucketName(v)
-	}
-	if v := c["function_trigger"].(string); v != "" {
-		fivetranConfig.FunctionTrigger(v)
-	}
-	if v := c["config_method"].(string); v != "" {
-		fivetranConfig.ConfigMethod(v)
-	}
-	if v := c["query_id"].(string); v != "" {
-		fivetranConfig.QueryID(v)
-	}
-	if v := c["update_config_on_each_sync"].(string); v != "" {
-		fivetranConfig.UpdateConfigOnEachSync(strToBool(v))
-	}
-	if v := c["site_urls"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.SiteURLs(xInterfaceStrXStr(v))
-	}
-	if v := c["path"].(string); v != "" {
-		fivetranConfig.Path(v)
-	}
-	if v := c["on_premise"].(string); v != "" {
-		fivetranConfig.OnPremise(strToBool(v))
-	}
-	if v := c["access_token"].(string); v != "" {
-		fivetranConfig.AccessToken(v)
-	}
-	if v := c["view_through_attribution_window_size"].(string); v != "" {
-		fivetranConfig.ViewThroughAttributionWindowSize(v)
-	}
-	if v := c["post_click_attribution_window_size"].(string); v != "" {
-		fivetranConfig.PostClickAttributionWindowSize(v)
-	}
-	if v := c["use_api_keys"].(string); v != "" {
-		fivetranConfig.UseAPIKeys(strToBool(v))
-	}
-	if v := c["api_keys"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.APIKeys(xInterfaceStrXStr(v))
-	}
-	if v := c["endpoint"].(string); v != "" {
-		fivetranConfig.Endpoint(v)
-	}
-	if v := c["identity"].(string); v != "" {
-		fivetranConfig.Identity(v)
-	}
-	if v := c["api_quota"].(string); v != "" {
-		fivetranConfig.APIQuota(strToInt(v))
-	}
-	if v := c["domain_name"].(string); v != "" {
-		fivetranConfig.DomainName(v)
-	}
-	if v := c["resource_url"].(string); v != "" {
-		fivetranConfig.ResourceURL(v)
-	}
-	if v := c["api_secret"].(string); v != "" {
-		fivetranConfig.APISecret(v)
-	}
-	if v := c["host"].(string); v != "" {
-		fivetranConfig.Host(v)
-	}
-	if v := c["hosts"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Hosts(xInterfaceStrXStr(v))
-	}
-	if v := c["tunnel_host"].(string); v != "" {
-		fivetranConfig.TunnelHost(v)
-	}
-	if v := c["tunnel_port"].(string); v != "" {
-		fivetranConfig.TunnelPort(strToInt(v))
-	}
-	if v := c["tunnel_user"].(string); v != "" {
-		fivetranConfig.TunnelUser(v)
-	}
-	if v := c["database"].(string); v != "" {
-		fivetranConfig.Database(v)
-	}
-	if v := c["datasource"].(string); v != "" {
-		fivetranConfig.Datasource(v)
-	}
-	if v := c["account"].(string); v != "" {
-		fivetranConfig.Account(v)
-	}
-	if v := c["role"].(string); v != "" {
-		fivetranConfig.Role(v)
-	}
-	if v := c["email"].(string); v != "" {
-		fivetranConfig.Email(v)
-	}
-	if v := c["account_id"].(string); v != "" {
-		fivetranConfig.AccountID(v)
-	}
-	if v := c["server_url"].(string); v != "" {
-		fivetranConfig.ServerURL(v)
-	}
-	if v := c["user_key"].(string); v != "" {
-		fivetranConfig.UserKey(v)
-	}
-	if v := c["api_version"].(string); v != "" {
-		fivetranConfig.APIVersion(v)
-	}
-	if v := c["daily_api_call_limit"].(string); v != "" {
-		fivetranConfig.DailyAPICallLimit(strToInt(v))
-	}
-	if v := c["time_zone"].(string); v != "" {
-		fivetranConfig.TimeZone(v)
-	}
-	if v := c["integration_key"].(string); v != "" {
-		fivetranConfig.IntegrationKey(v)
-	}
-	if v := c["advertisers"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Advertisers(xInterfaceStrXStr(v))
-	}
-	if v := c["engagement_attribution_window"].(string); v != "" {
-		fivetranConfig.EngagementAttributionWindow(v)
-	}
-	if v := c["conversion_report_time"].(string); v != "" {
-		fivetranConfig.ConversionReportTime(v)
-	}
-	if v := c["domain"].(string); v != "" {
-		fivetranConfig.Domain(v)
-	}
-	if v := c["update_method"].(string); v != "" {
-		fivetranConfig.UpdateMethod(v)
-	}
-	if v := c["connection_type"].(string); v != "" {
-		fivetranConfig.ConnectionType(v)
-	}
-	if v := c["replication_slot"].(string); v != "" {
-		fivetranConfig.ReplicationSlot(v)
-	}
-	if v := c["publication_name"].(string); v != "" {
-		fivetranConfig.PublicationName(v)
-	}
-	if v := c["data_center"].(string); v != "" {
-		fivetranConfig.DataCenter(v)
-	}
-	if v := c["api_token"].(string); v != "" {
-		fivetranConfig.APIToken(v)
-	}
-	if v := c["sub_domain"].(string); v != "" {
-		fivetranConfig.SubDomain(v)
-	}
-	if v := c["test_table_name"].(string); v != "" {
-		fivetranConfig.TestTableName(v)
-	}
-	if v := c["shop"].(string); v != "" {
-		fivetranConfig.Shop(v)
-	}
-	if v := c["organizations"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.Organizations(xInterfaceStrXStr(v))
-	}
-	if v := c["swipe_attribution_window"].(string); v != "" {
-		fivetranConfig.SwipeAttributionWindow(v)
-	}
-	if v := c["api_access_token"].(string); v != "" {
-		fivetranConfig.APIAccessToken(v)
-	}
-	if v := c["account_ids"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.AccountIDs(xInterfaceStrXStr(v))
-	}
-	if v := c["sid"].(string); v != "" {
-		fivetranConfig.SID(v)
-	}
-	if v := c["secret"].(string); v != "" {
-		fivetranConfig.Secret(v)
-	}
-	if v := c["oauth_token"].(string); v != "" {
-		fivetranConfig.OauthToken(v)
-	}
-	if v := c["oauth_token_secret"].(string); v != "" {
-		fivetranConfig.OauthTokenSecret(v)
-	}
-	if v := c["consumer_key"].(string); v != "" {
-		fivetranConfig.ConsumerKey(v)
-	}
-	if v := c["consumer_secret"].(string); v != "" {
-		fivetranConfig.ConsumerSecret(v)
-	}
-	if v := c["key"].(string); v != "" {
-		fivetranConfig.Key(v)
-	}
-	if v := c["advertisers_id"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.AdvertisersID(xInterfaceStrXStr(v))
-	}
-	if v := c["sync_format"].(string); v != "" {
-		fivetranConfig.SyncFormat(v)
-	}
-	if v := c["bucket_service"].(string); v != "" {
-		fivetranConfig.BucketService(v)
-	}
-
-	if v := c["report_url"].(string); v != "" {
-		fivetranConfig.ReportURL(v)
-	}
-	if v := c["unique_id"].(string); v != "" {
-		fivetranConfig.UniqueID(v)
-	}
-	if v := c["auth_type"].(string); v != "" {
-		fivetranConfig.AuthType(v)
-	}
-	if v := c["is_new_package"].(string); v != "" {
-		fivetranConfig.IsNewPackage(strToBool(v))
-	}
-	if v := c["adobe_analytics_configurations"].(*schema.Set).List(); len(v) > 0 {
-		fivetranConfig.AdobeAnalyticsConfigurations(resourceConnectorCreateConfigAdobeAnalyticsConfigurations(v))
-	}
-	if v := c["is_multi_entity_feature_enabled"].(string); v != "" {
-		fivetranConfig.IsMultiEntityFeatureEnabled(strToBool(v))
-	}
-	if v := c["api_type"].(string); v != "" {
-		fivetranConfig.ApiType(v)
-	}
-	if v := c["base_url"].(string); v != "" {
-		fivetranConfig.BaseUrl(v)
-	}
-	if v := c["entity_id"].(string); v != "" {
-		fivetranConfig.EntityId(v)
-	}
-	if v := c["soap_uri"].(string); v != "" {
-		fivetranConfig.SoapUri(v)
-	}
-	if v := c["user_id"].(string); v != "" {
-		fivetranConfig.UserId(v)
-	}
-	if v := c["encryption_key"].(string); v != "" {
-		fivetranConfig.EncryptionKey(v)
-	}
-	if v := c["pat"].(string); v != "" {
-		fivetranConfig.PAT(v)
-	}
-	if v := c["always_encrypted"].(string); v != "" {
-		fivetranConfig.AlwaysEncrypted(strToBool(v))
-	}
-	if v := c["eu_region"].(string); v != "" {
-		fivetranConfig.EuRegion(strToBool(v))
-	}
-	if v := c["token_key"].(string); v != "" {
-		fivetranConfig.TokenKey(v)
-	}
-	if v := c["token_secret"].(string); v != "" {
-		fivetranConfig.TokenSecret(v)
-	}
-	if v := c["public_key"].(string); v != "" {
-		fivetranConfig.PublicKey(v)
-	}
-
-	return fivetranConfig
-}
-
-func resourceConnectorCreateConfig(fivetranConfig *fivetran.ConnectorConfig, destination_schema []interface{}) *fivetran.ConnectorConfig {
-	d := destination_schema[0].(map[string]interface{})
-	if v := d["name"].(string); v != "" {
-		fivetranConfig.Schema(v)
-	}
-	if v := d["table"].(string); v != "" {
-		fivetranConfig.Table(v)
-	}
-	if v := d["prefix"].(string); v != "" {
-		fivetranConfig.SchemaPrefix(v)
-	}
-
-	return fivetranConfig
-}
-
-func resourceConnectorCreateFunctionSecrets(xi []interface{}) []*fivetran.FunctionSecret {
-	functionSecrets := make([]*fivetran.FunctionSecret, len(xi))
-	for i, v := range xi {
-		vmap := v.(map[string]interface{})
-		// As the fields are marked as required in schema we can skip any checks here
-		functionSecrets[i] =
-			fivetran.NewFunctionSecret().
-				Key(vmap["key"].(string)).
-				Value(vmap["value"].(string))
-	}
-	return functionSecrets
-}
-
-func resourceConnectorCreateConfigProjectCredentials(xi []interface{}) []*fivetran.ConnectorConfigProjectCredentials {
-	projectCredentials := make([]*fivetran.ConnectorConfigProjectCredentials, len(xi))
-	for i, v := range xi {
-		pc := fivetran.NewConnectorConfigProjectCredentials()
-		if project, ok := v.(map[string]interface{})["project"].(string); ok && project != "" {
-			pc.Project(project)
-		}
-		if apiKey, ok := v.(map[string]interface{})["api_key"].(string); ok && apiKey != "" {
-			pc.APIKey(apiKey)
-		}
-		if secretKey, ok := v.(map[string]interface{})["secret_key"].(string); ok && secretKey != "" {
-			pc.SecretKey(secretKey)
-		}
-		projectCredentials[i] = pc
-	}
-
-	return projectCredentials
-}
-
-func resourceConnectorCreateConfigCustomTables(xi []interface{}) []*fivetran.ConnectorConfigCustomTables {
-	customTables := make([]*fivetran.ConnectorConfigCustomTables, len(xi))
-	for i, v := range xi {
-		ct := fivetran.NewConnectorConfigCustomTables()
-		if tableName, ok := v.(map[string]interface{})["table_name"].(string); ok && tableName != "" {
-			ct.TableName(tableName)
-		}
-		if configType, ok := v.(map[string]interface{})["config_type"].(string); ok && configType != "" {
-			ct.ConfigType(configType)
-		}
-		if fields, ok := v.(map[string]interface{})["fields"]; ok {
-			ct.Fields(xInterfaceStrXStr(fields.(*schema.Set).List()))
-		}
-		if breakdowns, ok := v.(map[string]interface{})["breakdowns"]; ok {
-			ct.Breakdowns(xInterfaceStrXStr(breakdowns.(*schema.Set).List()))
-		}
-		if actionBreakdowns, ok := v.(map[string]interface{})["action_breakdowns"]; ok {
-			ct.ActionBreakdowns(xInterfaceStrXStr(actionBreakdowns.(*schema.Set).List()))
-		}
-		if aggregation, ok := v.(map[string]interface{})["aggregation"].(string); ok && aggregation != "" {
-			ct.Aggregation(aggregation)
-		}
-		if actionReportTime, ok := v.(map[string]interface{})["action_report_time"].(string); ok && actionReportTime != "" {
-			ct.ActionReportTime(actionReportTime)
-		}
-		if clickAttributionWindow, ok := v.(map[string]interface{})["click_attribution_window"].(string); ok && clickAttributionWindow != "" {
-			ct.ClickAttributionWindow(clickAttributionWindow)
-		}
-		if viewAttributionWindow, ok := v.(map[string]interface{})["view_attribution_window"].(string); ok && viewAttributionWindow != "" {
-			ct.ViewAttributionWindow(viewAttributionWindow)
-		}
-		if prebuiltReportName, ok := v.(map[string]interface{})["prebuilt_report_name"].(string); ok && prebuiltReportName != "" {
-			ct.PrebuiltReportName(prebuiltReportName)
-		}
-		customTables[i] = ct
-	}
-
-	return customTables
-}
-
-func resourceConnectorCreateConfigAdobeAnalyticsConfigurations(xi []interface{}) []*fivetran.ConnectorConfigAdobeAnalyticsConfiguration {
-	configurations := make([]*fivetran.ConnectorConfigAdobeAnalyticsConfiguration, len(xi))
-	for i, v := range xi {
-		c := fivetran.NewConnectorConfigAdobeAnalyticsConfiguration()
-
-		if syncMode, ok := v.(map[string]interface{})["sync_mode"].(string); ok && syncMode != "" {
-			c.SyncMode(syncMode)
-		}
-		if metrics, ok := v.(map[string]interface{})["metrics"]; ok {
-			c.Metrics(xInterfaceStrXStr(metrics.(*schema.Set).List()))
-		}
-		if reportSuites, ok := v.(map[string]interface{})["report_suites"]; ok {
-			c.ReportSuites(xInterfaceStrXStr(reportSuites.(*schema.Set).List()))
-		}
-		if segments, ok := v.(map[string]interface{})["segments"]; ok {
-			c.Segments(xInterfaceStrXStr(segments.(*schema.Set).List()))
-		}
-		if elements, ok := v.(map[string]interface{})["elements"]; ok {
-			c.Elements(xInterfaceStrXStr(elements.(*schema.Set).List()))
-		}
-		if calculatedMetrics, ok := v.(map[string]interface{})["calculated_metrics"]; ok {
-			c.CalculatedMetrics(xInterfaceStrXStr(calculatedMetrics.(*schema.Set).List()))
-		}
-
-		configurations[i] = c
-	}
-
-	return configurations
-}
-
-func resourceConnectorCreateConfigReports(xi []interface{}) []*fivetran.ConnectorConfigReports {
-	reports := make([]*fivetran.ConnectorConfigReports, len(xi))
-	for i, v := range xi {
-		r := fivetran.NewConnectorConfigReports()
-		if table, ok := v.(map[string]interface{})["table"].(string); ok && table != "" {
-			r.Table(table)
-		}
-		if configType, ok := v.(map[string]interface{})["config_type"].(string); ok && configType != "" {
-			r.ConfigType(configType)
-		}
-		if prebuiltReport, ok := v.(map[string]interface{})["prebuilt_report"].(string); ok && prebuiltReport != "" {
-			r.PrebuiltReport(prebuiltReport)
-		}
-		if reportType, ok := v.(map[string]interface{})["report_type"].(string); ok && reportType != "" {
-			r.ReportType(reportType)
-		}
-		if fields, ok := v.(map[string]interface{})["fields"]; ok {
-			r.Fields(xInterfaceStrXStr(fields.(*schema.Set).List()))
-		}
-		if dimensions, ok := v.(map[string]interface{})["dimensions"]; ok {
-			r.Dimensions(xInterfaceStrXStr(dimensions.(*schema.Set).List()))
-		}
-		if metrics, ok := v.(map[string]interface{})["metrics"]; ok {
-			r.Metrics(xInterfaceStrXStr(metrics.(*schema.Set).List()))
-		}
-		if segments, ok := v.(map[string]interface{})["segments"]; ok {
-			r.Segments(xInterfaceStrXStr(segments.(*schema.Set).List()))
-		}
-		if filter, ok := v.(map[string]interface{})["filter"].(string); ok && filter != "" {
-			r.Filter(filter)
-		}
-		reports[i] = r
-	}
-
-	return reports
-}
-
-func resourceConnectorCreateAuth(auth []interface{}) *fivetran.ConnectorAuth {
-	fivetranAuth := fivetran.NewConnectorAuth()
-
-	if len(auth) < 1 {
-		return fivetranAuth
-	}
-	if auth[0] == nil {
-		return fivetranAuth
-	}
-
-	a := auth[0].(map[string]interface{})
-
-	if v := a["client_access"].([]interface{}); len(v) > 0 {
-		fivetranAuth.ClientAccess(resourceConnectorCreateAuthClientAccess(v))
-	}
-	if v := a["refresh_token"].(string); v != "" {
-		fivetranAuth.RefreshToken(v)
-	}
-	if v := a["access_token"].(string); v != "" {
-		fivetranAuth.AccessToken(v)
-	}
-	if v := a["realm_id"].(string); v != "" {
-		fivetranAuth.RealmID(v)
-	}
-
-	return fivetranAuth
-}
-
-func resourceConnectorCreateAuthClientAccess(clientAccess []interface{}) *fivetran.ConnectorAuthClientAccess {
-	fivetranAuthClientAccess := fivetran.NewConnectorAuthClientAccess()
-
-	if len(clientAccess) < 1 {
-		return fivetranAuthClientAccess
-	}
-	if clientAccess[0] == nil {
-		return fivetranAuthClientAccess
-	}
-
-	ca := clientAccess[0].(map[string]interface{})
-	if v := ca["client_id"].(string); v != "" {
-		fivetranAuthClientAccess.ClientID(v)
-	}
-	if v := ca["client_secret"].(string); v != "" {
-		fivetranAuthClientAccess.ClientSecret(v)
-	}
-	if v := ca["user_agent"].(string); v != "" {
-		fivetranAuthClientAccess.UserAgent(v)
-	}
-	if v := ca["developer_token"].(string); v != "" {
-		fivetranAuthClientAccess.DeveloperToken(v)
-	}
-
-	return fivetranAuthClientAccess
-}
-
-// resourceConnectorReadStatus receives a *fivetran.ConnectorDetailsResponse and returns a []interface{}
-// containing the data type accepted by the "status" list.
-func resourceConnectorReadStatus(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
-	status := make([]interface{}, 1)
-
-	s := make(map[string]interface{})
-	mapAddStr(s, "setup_state", resp.Data.Status.SetupState)
-	mapAddStr(s, "sync_state", resp.Data.Status.SyncState)
-	mapAddStr(s, "update_state", resp.Data.Status.UpdateState)
-	mapAddStr(s, "is_historical_sync", boolPointerToStr(resp.Data.Status.IsHistoricalSync))
-	mapAddXInterface(s, "tasks", resourceConnectorReadStatusFlattenTasks(resp))
-	mapAddXInterface(s, "warnings", resourceConnectorReadStatusFlattenWarnings(resp))
-	status[0] = s
-
-	return status
-}
-
-func resourceConnectorReadStatusFlattenTasks(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
-	if len(resp.Data.Status.Tasks) < 1 {
-		return make([]interface{}, 0)
-	}
-
-	tasks := make([]interface{}, len(resp.Data.Status.Tasks))
-	for i, v := range resp.Data.Status.Tasks {
-		task := make(map[string]interface{})
-		mapAddStr(task, "code", v.Code)
-		mapAddStr(task, "message", v.Message)
-
-		tasks[i] = task
-	}
-
-	return tasks
-}
-
-func resourceConnectorReadStatusFlattenWarnings(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
-	if len(resp.Data.Status.Warnings) < 1 {
-		return make([]interface{}, 0)
-	}
-
-	warnings := make([]interface{}, len(resp.Data.Status.Warnings))
-	for i, v := range resp.Data.Status.Warnings {
-		warning := make(map[string]interface{})
-		mapAddStr(warning, "code", v.Code)
-		mapAddStr(warning, "message", v.Message)
-
-		warnings[i] = warning
-	}
-
-	return warnings
-}
-
-// dataSourceConnectorReadConfig receives a *fivetran.ConnectorDetailsResponse and returns a []interface{}
-// containing the data type accepted by the "config" list.
-func resourceConnectorReadConfig(resp *fivetran.ConnectorCustomMergedDetailsResponse, currentConfig []interface{}) []interface{} {
-	config := make([]interface{}, 1)
-
-	c := make(map[string]interface{})
-
-	// get sensitive fields from the currentConfig to prevent drifting (Fivetran returns this values masked)
-	if len(currentConfig) > 0 {
-		resourceConfig := currentConfig[0].(map[string]interface{})
-		mapAddStr(c, "password", resourceConfig["password"].(string))
-		mapAddStr(c, "client_secret", resourceConfig["client_secret"].(string))
-		mapAddStr(c, "private_key", resourceConfig["private_key"].(string))
-		mapAddStr(c, "s3role_arn", resourceConfig["s3role_arn"].(string))
-		mapAddStr(c, "ftp_password", resourceConfig["ftp_password"].(string))
-		mapAddStr(c, "sftp_password", resourceConfig["sftp_password"].(string))
-		mapAddStr(c, "api_key", resourceConfig["api_key"].(string))
-		mapAddStr(c, "role_arn", resourceConfig["role_arn"].(string))
-		mapAddStr(c, "secret_key", resourceConfig["secret_key"].(string))
-		mapAddStr(c, "pem_certificate", resourceConfig["pem_certificate"].(string))
-		mapAddStr(c, "access_token", resourceConfig["access_token"].(string))
-		mapAddStr(c, "api_secret", resourceConfig["api_secret"].(string))
-		mapAddStr(c, "api_access_token", resourceConfig["api_access_token"].(string))
-		mapAddStr(c, "secret", resourceConfig["secret"].(string))
-		mapAddStr(c, "consumer_secret", resourceConfig["consumer_secret"].(string))
-		mapAddStr(c, "secrets", resourceConfig["secrets"].(string))
-		mapAddStr(c, "api_token", resourceConfig["api_token"].(string))
-		mapAddStr(c, "consumer_key", resourceConfig["consumer_key"].(string))
-		mapAddStr(c, "encryption_key", resourceConfig["encryption_key"].(string))
-		mapAddStr(c, "oauth_token", resourceConfig["oauth_token"].(string))
-		mapAddStr(c, "oauth_token_secret", resourceConfig["oauth_token_secret"].(string))
-		mapAddStr(c, "pat", resourceConfig["pat"].(string))
-		mapAddStr(c, "function_trigger", resourceConfig["function_trigger"].(string))
-		mapAddStr(c, "token_key", resourceConfig["token_key"].(string))
-		mapAddStr(c, "token_secret", resourceConfig["token_secret"].(string))
-		mapAddXInterface(c, "api_keys", resourceConfig["api_keys"].(*schema.Set).List())
-		mapAddStr(c, "agent_password", resourceConfig["agent_password"].(string))
-		mapAddStr(c, "asm_password", resourceConfig["asm_password"].(string))
-		mapAddStr(c, "login_password", resourceConfig["login_password"].(string))
-	}
-
-	mapAddXInterface(c, "project_credentials", resourceConnectorReadConfigFlattenProjectCredentials(resp, currentConfig))
-	mapAddXInterface(c, "secrets_list", resourceConnectorReadConfigFlattenSecretsList(resp, currentConfig))
-
-	// Collections
-	mapAddXInterface(c, "report_suites", xStrXInterface(resp.Data.Config.ReportSuites))
-	mapAddXInterface(c, "elements", xStrXInterface(resp.Data.Config.Elements))
-	mapAddXInterface(c, "metrics", xStrXInterface(resp.Data.Config.Metrics))
-	mapAddXInterface(c, "advertisables", xStrXInterface(resp.Data.Config.Advertisables))
-	mapAddXInterface(c, "dimensions", xStrXInterface(resp.Data.Config.Dimensions))
-	mapAddXInterface(c, "selected_exports", xStrXInterface(resp.Data.Config.SelectedExports))
-	mapAddXInterface(c, "apps", xStrXInterface(resp.Data.Config.Apps))
-	mapAddXInterface(c, "sales_accounts", xStrXInterface(resp.Data.Config.SalesAccounts))
-	mapAddXInterface(c, "finance_accounts", xStrXInterface(resp.Data.Config.FinanceAccounts))
-	mapAddXInterface(c, "projects", xStrXInterface(resp.Data.Config.Projects))
-	mapAddXInterface(c, "user_profiles", xStrXInterface(resp.Data.Config.UserProfiles))
-	mapAddXInterface(c, "report_configuration_ids", xStrXInterface(resp.Data.Config.ReportConfigurationIDs))
-	mapAddXInterface(c, "custom_tables", resourceConnectorReadConfigFlattenCustomTables(resp))
-	mapAddXInterface(c, "pages", xStrXInterface(resp.Data.Config.Pages))
-	mapAddXInterface(c, "accounts", xStrXInterface(resp.Data.Config.Accounts))
-	mapAddXInterface(c, "fields", xStrXInterface(resp.Data.Config.Fields))
-	mapAddXInterface(c, "breakdowns", xStrXInterface(resp.Data.Config.Breakdowns))
-	mapAddXInterface(c, "action_breakdowns", xStrXInterface(resp.Data.Config.ActionBreakdowns))
-	mapAddXInterface(c, "repositories", xStrXInterface(resp.Data.Config.Repositories))
-	mapAddXInterface(c, "dimension_attributes", xStrXInterface(resp.Data.Config.DimensionAttributes))
-	mapAddXInterface(c, "columns", xStrXInterface(resp.Data.Config.Columns))
-	mapAddXInterface(c, "manager_accounts", xStrXInterface(resp.Data.Config.ManagerAccounts))
-	mapAddXInterface(c, "reports", resourceConnectorReadConfigFlattenReports(resp))
-	mapAddXInterface(c, "site_urls", xStrXInterface(resp.Data.Config.SiteURLs))
-	mapAddXInterface(c, "profiles", xStrXInterface(resp.Data.Config.Profiles))
-	mapAddXInterface(c, "hosts", xStrXInterface(resp.Data.Config.Hosts))
-	mapAddXInterface(c, "adobe_analytics_configurations", resourceConnectorReadConfigFlattenAdobeAnalyticsConfigurations(resp))
-	mapAddXInterface(c, "advertisers", xStrXInterface(resp.Data.Config.Advertisers))
-	mapAddXInterface(c, "organizations", xStrXInterface(resp.Data.Config.Organizations))
-	mapAddXInterface(c, "account_ids", xStrXInterface(resp.Data.Config.AccountIDs))
-	mapAddXInterface(c, "advertisers_id", xStrXInterface(resp.Data.Config.AdvertisersID))
-
-	if v, ok := resp.Data.CustomConfig["packed_mode_tables"].([]interface{}); ok {
-		mapAddXInterface(c, "packed_mode_tables", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["properties"].([]interface{}); ok {
-		mapAddXInterface(c, "properties", v)
-	}
-
-	// Boolean fields
-	mapAddStr(c, "is_ftps", boolPointerToStr(resp.Data.Config.IsFTPS))
-	mapAddStr(c, "sftp_is_key_pair", boolPointerToStr(resp.Data.Config.SFTPIsKeyPair))
-	mapAddStr(c, "is_keypair", boolPointerToStr(resp.Data.Config.IsKeypair))
-	mapAddStr(c, "sync_data_locker", boolPointerToStr(resp.Data.Config.SyncDataLocker))
-	mapAddStr(c, "enable_all_dimension_combinations", boolPointerToStr(resp.Data.Config.EnableAllDimensionCombinations))
-	mapAddStr(c, "use_webhooks", boolPointerToStr(resp.Data.Config.UseWebhooks))
-	mapAddStr(c, "update_config_on_each_sync", boolPointerToStr(resp.Data.Config.UpdateConfigOnEachSync))
-	mapAddStr(c, "on_premise", boolPointerToStr(resp.Data.Config.OnPremise))
-	mapAddStr(c, "always_encrypted", boolPointerToStr(resp.Data.Config.AlwaysEncrypted))
-	mapAddStr(c, "is_new_package", boolPointerToStr(resp.Data.Config.IsNewPackage))
-	mapAddStr(c, "is_multi_entity_feature_enabled", boolPointerToStr(resp.Data.Config.IsMultiEntityFeatureEnabled))
-	mapAddStr(c, "eu_region", boolPointerToStr(resp.Data.Config.EuRegion))
-	mapAddStr(c, "is_secure", boolPointerToStr(resp.Data.Config.IsSecure))
-	mapAddStr(c, "use_api_keys", boolPointerToStr(resp.Data.Config.UseAPIKeys))
-
-	if v, ok := resp.Data.CustomConfig["is_account_level_connector"].(bool); ok {
-		mapAddStr(c, "is_account_level_connector", boolToStr(v))
-	}
-
-	// Integer fields
-	mapAddStr(c, "ftp_port", intPointerToStr(resp.Data.Config.FTPPort))
-	mapAddStr(c, "sftp_port", intPointerToStr(resp.Data.Config.SFTPPort))
-	mapAddStr(c, "conversion_window_size", intPointerToStr(resp.Data.Config.ConversionWindowSize))
-	mapAddStr(c, "port", intPointerToStr(resp.Data.Config.Port))
-	mapAddStr(c, "api_quota", intPointerToStr(resp.Data.Config.APIQuota))
-	mapAddStr(c, "tunnel_port", intPointerToStr(resp.Data.Config.TunnelPort))
-	mapAddStr(c, "daily_api_call_limit", intPointerToStr(resp.Data.Config.DailyAPICallLimit))
-	mapAddStr(c, "skip_after", intPointerToStr(resp.Data.Config.SkipAfter))
-	mapAddStr(c, "skip_before", intPointerToStr(resp.Data.Config.SkipBefore))
-
-	// String fields
-	mapAddStr(c, "sheet_id", resp.Data.Config.SheetID)
-	mapAddStr(c, "share_url", resp.Data.Config.ShareURL)
-	mapAddStr(c, "named_range", resp.Data.Config.NamedRange)
-	mapAddStr(c, "client_id", resp.Data.Config.ClientID)
-	mapAddStr(c, "technical_account_id", resp.Data.Config.TechnicalAccountID)
-	mapAddStr(c, "organization_id", resp.Data.Config.OrganizationID)
-
-	if v, ok := resp.Data.CustomConfig["sync_method"].(string); ok {
-		mapAddStr(c, "sync_method", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["group_name"].(string); ok {
-		mapAddStr(c, "group_name", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["pdb_name"].(string); ok {
-		mapAddStr(c, "pdb_name", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["agent_host"].(string); ok {
-		mapAddStr(c, "agent_host", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["agent_port"].(float64); ok {
-		mapAddStr(c, "agent_port", strconv.Itoa((int(v))))
-	}
-
-	if v, ok := resp.Data.CustomConfig["agent_user"].(string); ok {
-		mapAddStr(c, "agent_user", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["agent_public_cert"].(string); ok {
-		mapAddStr(c, "agent_public_cert", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["agent_ora_home"].(string); ok {
-		mapAddStr(c, "agent_ora_home", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["tns"].(string); ok {
-		mapAddStr(c, "tns", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["use_oracle_rac"].(bool); ok {
-		mapAddStr(c, "use_oracle_rac", boolToStr(v))
-	}
-
-	if v, ok := resp.Data.CustomConfig["asm_option"].(bool); ok {
-		mapAddStr(c, "asm_option", boolToStr(v))
-	}
-
-	if v, ok := resp.Data.CustomConfig["asm_user"].(string); ok {
-		mapAddStr(c, "asm_user", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["asm_oracle_home"].(string); ok {
-		mapAddStr(c, "asm_oracle_home", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["asm_tns"].(string); ok {
-		mapAddStr(c, "asm_tns", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["sap_user"].(string); ok {
-		mapAddStr(c, "sap_user", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["organization"].(string); ok {
-		mapAddStr(c, "organization", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["access_key"].(string); ok {
-		mapAddStr(c, "access_key", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["domain_host_name"].(string); ok {
-		mapAddStr(c, "domain_host_name", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["client_name"].(string); ok {
-		mapAddStr(c, "client_name", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["domain_type"].(string); ok {
-		mapAddStr(c, "domain_type", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["connection_method"].(string); ok {
-		mapAddStr(c, "connection_method", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["is_single_table_mode"].(bool); ok {
-		mapAddStr(c, "is_single_table_mode", boolToStr(v))
-	}
-
-	if v, ok := resp.Data.CustomConfig["company_id"].(string); ok {
-		mapAddStr(c, "company_id", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["environment"].(string); ok {
-		mapAddStr(c, "environment", v)
-	}
-
-	if v, ok := resp.Data.CustomConfig["is_public"].(bool); ok {
-		mapAddStr(c, "is_public", boolToStr(v))
-	}
-
-	if v, ok := resp.Data.CustomConfig["empty_header"].(bool); ok {
-		mapAddStr(c, "empty_header", boolToStr(v))
-	}
-
-	if v, ok := resp.Data.CustomConfig["list_strategy"].(string); ok {
-		mapAddStr(c, "list_strategy", v)
-	}
-
-	mapAddStr(c, "sync_mode", resp.Data.Config.SyncMode)
-	mapAddStr(c, "date_granularity", resp.Data.Config.DateGranularity)
-	mapAddStr(c, "timeframe_months", resp.Data.Config.TimeframeMonths)
-	mapAddStr(c, "source", resp.Data.Config.Source)
-	mapAddStr(c, "s3bucket", resp.Data.Config.S3Bucket)
-	mapAddStr(c, "abs_connection_string", resp.Data.Config.ABSConnectionString)
-	mapAddStr(c, "abs_container_name", resp.Data.Config.ABSContainerName)
-	mapAddStr(c, "folder_id", resp.Data.Config.FolderId)
-	mapAddStr(c, "ftp_host", resp.Data.Config.FTPHost)
-	mapAddStr(c, "ftp_user", resp.Data.Config.FTPUser)
-	mapAddStr(c, "sftp_host", resp.Data.Config.SFTPHost)
-	mapAddStr(c, "sftp_user", resp.Data.Config.SFTPUser)
-	mapAddStr(c, "report_type", resp.Data.Config.ReportType)
-	mapAddStr(c, "external_id", resp.Data.Config.ExternalID)
-	mapAddStr(c, "bucket", resp.Data.Config.Bucket)
-	mapAddStr(c, "prefix", resp.Data.Config.Prefix)
-	mapAddStr(c, "pattern", resp.Data.Config.Pattern)
-	mapAddStr(c, "file_type", resp.Data.Config.FileType)
-	mapAddStr(c, "compression", resp.Data.Config.Compression)
-	mapAddStr(c, "on_error", resp.Data.Config.OnError)
-	mapAddStr(c, "append_file_option", resp.Data.Config.AppendFileOption)
-	mapAddStr(c, "archive_pattern", resp.Data.Config.ArchivePattern)
-	mapAddStr(c, "null_sequence", resp.Data.Config.NullSequence)
-	mapAddStr(c, "delimiter", resp.Data.Config.Delimiter)
-	mapAddStr(c, "escape_char", resp.Data.Config.EscapeChar)
-	mapAddStr(c, "auth_mode", resp.Data.Config.AuthMode)
-	mapAddStr(c, "user_name", resp.Data.Config.UserName)
-	mapAddStr(c, "username", resp.Data.Config.Username)
-	mapAddStr(c, "certificate", resp.Data.Config.Certificate)
-	mapAddStr(c, "consumer_group", resp.Data.Config.ConsumerGroup)
-	mapAddStr(c, "servers", resp.Data.Config.Servers)
-	mapAddStr(c, "message_type", resp.Data.Config.MessageType)
-	mapAddStr(c, "sync_type", resp.Data.Config.SyncType)
-	mapAddStr(c, "security_protocol", resp.Data.Config.SecurityProtocol)
-	mapAddStr(c, "app_sync_mode", resp.Data.Config.AppSyncMode)
-	mapAddStr(c, "sales_account_sync_mode", resp.Data.Config.SalesAccountSyncMode)
-	mapAddStr(c, "finance_account_sync_mode", resp.Data.Config.FinanceAccountSyncMode)
-	mapAddStr(c, "access_key_id", resp.Data.Config.AccessKeyID)
-	mapAddStr(c, "home_folder", resp.Data.Config.HomeFolder)
-	mapAddStr(c, "function", resp.Data.Config.Function)
-	mapAddStr(c, "region", resp.Data.Config.Region)
-	mapAddStr(c, "container_name", resp.Data.Config.ContainerName)
-	mapAddStr(c, "connection_string", resp.Data.Config.ConnectionString)
-	mapAddStr(c, "connection_type", resp.Data.Config.ConnectionType)
-	mapAddStr(c, "function_app", resp.Data.Config.FunctionApp)
-	mapAddStr(c, "function_name", resp.Data.Config.FunctionName)
-	mapAddStr(c, "function_key", resp.Data.Config.FunctionKey)
-	mapAddStr(c, "public_key", resp.Data.Config.PublicKey)
-	mapAddStr(c, "merchant_id", resp.Data.Config.MerchantID)
-	mapAddStr(c, "api_url", resp.Data.Config.APIURL)
-	mapAddStr(c, "cloud_storage_type", resp.Data.Config.CloudStorageType)
-	mapAddStr(c, "s3external_id", resp.Data.Config.S3ExternalID)
-	mapAddStr(c, "s3folder", resp.Data.Config.S3Folder)
-	mapAddStr(c, "gcs_bucket", resp.Data.Config.GCSBucket)
-	mapAddStr(c, "gcs_folder", resp.Data.Config.GCSFolder)
-	mapAddStr(c, "instance", resp.Data.Config.Instance)
-	mapAddStr(c, "aws_region_code", resp.Data.Config.AWSRegionCode)
-	mapAddStr(c, "aggregation", resp.Data.Config.Aggregation)
-	mapAddStr(c, "config_type", resp.Data.Config.ConfigType)
-	mapAddStr(c, "prebuilt_report", resp.Data.Config.PrebuiltReport)
-	mapAddStr(c, "action_report_time", resp.Data.Config.ActionReportTime)
-	mapAddStr(c, "click_attribution_window", resp.Data.Config.ClickAttributionWindow)
-	mapAddStr(c, "view_attribution_window", resp.Data.Config.ViewAttributionWindow)
-	mapAddStr(c, "subdomain", resp.Data.Config.Subdomain)
-	mapAddStr(c, "host", resp.Data.Config.Host)
-	mapAddStr(c, "user", resp.Data.Config.User)
-
-	mapAddStr(c, "network_code", resp.Data.Config.NetworkCode)
-	mapAddStr(c, "customer_id", resp.Data.Config.CustomerID)
-	mapAddStr(c, "project_id", resp.Data.Config.ProjectID)
-	mapAddStr(c, "dataset_id", resp.Data.Config.DatasetID)
-	mapAddStr(c, "bucket_name", resp.Data.Config.BucketName)
-	mapAddStr(c, "config_method", resp.Data.Config.ConfigMethod)
-	mapAddStr(c, "query_id", resp.Data.Config.QueryID)
-	mapAddStr(c, "path", resp.Data.Config.Path)
-	mapAddStr(c, "view_through_attribution_window_size", resp.Data.Config.ViewThroughAttributionWindowSize)
-	mapAddStr(c, "post_click_attribution_window_size", resp.Data.Config.PostClickAttributionWindowSize)
-
-	mapAddStr(c, "endpoint", resp.Data.Config.Endpoint)
-	mapAddStr(c, "identity", resp.Data.Config.Identity)
-	mapAddStr(c, "tunnel_host", resp.Data.Config.TunnelHost)
-	mapAddStr(c, "domain_name", resp.Data.Config.DomainName)
-	mapAddStr(c, "resource_url", resp.Data.Config.ResourceURL)
-	mapAddStr(c, "tunnel_user", resp.Data.Config.TunnelUser)
-	mapAddStr(c, "database", resp.Data.Config.Database)
-	mapAddStr(c, "datasource", resp.Data.Config.Datasource)
-	mapAddStr(c, "account", resp.Data.Config.Account)
-	mapAddStr(c, "role", resp.Data.Config.Role)
-	mapAddStr(c, "email", resp.Data.Config.Email)
-	mapAddStr(c, "account_id", resp.Data.Config.AccountID)
-	mapAddStr(c, "server_url", resp.Data.Config.ServerURL)
-	mapAddStr(c, "user_key", resp.Data.Config.UserKey)
-	mapAddStr(c, "api_version", resp.Data.Config.APIVersion)
-	mapAddStr(c, "time_zone", resp.Data.Config.TimeZone)
-	mapAddStr(c, "integration_key", resp.Data.Config.IntegrationKey)
-	mapAddStr(c, "engagement_attribution_window", resp.Data.Config.EngagementAttributionWindow)
-	mapAddStr(c, "conversion_report_time", resp.Data.Config.ConversionReportTime)
-	mapAddStr(c, "domain", resp.Data.Config.Domain)
-	mapAddStr(c, "update_method", resp.Data.Config.UpdateMethod)
-	mapAddStr(c, "replication_slot", resp.Data.Config.ReplicationSlot)
-	mapAddStr(c, "publication_name", resp.Data.Config.PublicationName)
-	mapAddStr(c, "data_center", resp.Data.Config.DataCenter)
-	mapAddStr(c, "sub_domain", resp.Data.Config.SubDomain)
-	mapAddStr(c, "test_table_name", resp.Data.Config.TestTableName)
-	mapAddStr(c, "shop", resp.Data.Config.Shop)
-	mapAddStr(c, "swipe_attribution_window", resp.Data.Config.SwipeAttributionWindow)
-	mapAddStr(c, "sid", resp.Data.Config.SID)
-	mapAddStr(c, "key", resp.Data.Config.Key)
-	mapAddStr(c, "sync_format", resp.Data.Config.SyncFormat)
-	mapAddStr(c, "bucket_service", resp.Data.Config.BucketService)
-	mapAddStr(c, "report_url", resp.Data.Config.ReportURL)
-	mapAddStr(c, "unique_id", resp.Data.Config.UniqueID)
-	mapAddStr(c, "auth_type", resp.Data.Config.AuthType)
-	mapAddStr(c, "latest_version", resp.Data.Config.LatestVersion)
-	mapAddStr(c, "authorization_method", resp.Data.Config.AuthorizationMethod)
-	mapAddStr(c, "service_version", resp.Data.Config.ServiceVersion)
-	mapAddStr(c, "last_synced_changes__utc_", resp.Data.Config.LastSyncedChangesUtc)
-	mapAddStr(c, "api_type", resp.Data.Config.ApiType)
-	mapAddStr(c, "base_url", resp.Data.Config.BaseUrl)
-	mapAddStr(c, "entity_id", resp.Data.Config.EntityId)
-	mapAddStr(c, "soap_uri", resp.Data.Config.SoapUri)
-	mapAddStr(c, "user_id", resp.Data.Config.UserId)
-
-	config[0] = c
-
-	return config
-}
-
-func resourceConnectorReadConfigFlattenProjectCredentials(resp *fivetran.ConnectorCustomMergedDetailsResponse, currentConfig []interface{}) []interface{} {
-	if len(resp.Data.Config.ProjectCredentials) < 1 {
-		return make([]interface{}, 0)
-	}
-
-	projectCredentials := make([]interface{}, len(resp.Data.Config.ProjectCredentials))
-	for i, v := range resp.Data.Config.ProjectCredentials {
-		pc := make(map[string]interface{})
-		mapAddStr(pc, "project", v.Project)
-		if len(currentConfig) > 0 {
-			// The REST API sends the fields "api_key" and "secret_key" masked. We use the state stored config here.
-			mapAddStr(pc, "api_key", resourceConnectorReadConfigFlattenProjectCredentialsGetStateValue(v.Project, "api_key", currentConfig))
-			mapAddStr(pc, "secret_key", resourceConnectorReadConfigFlattenProjectCredentialsGetStateValue(v.Project, "secret_key", currentConfig))
-		} else {
-			// On Import these values will be masked, but we can't rely on state
-			mapAddStr(pc, "api_key", v.APIKey)
-			mapAddStr(pc, "secret_key", v.SecretKey)
-		}
-		projectCredentials[i] = pc
-	}
-
-	return projectCredentials
-}
-
-func resourceConnectorReadConfigFlattenSecretsList(resp *fivetran.ConnectorCustomMergedDetailsResponse, currentConfig []interface{}) []interface{} {
-	if len(resp.Data.Config.SecretsList) < 1 {
-		return make([]interface{}, 0)
-	}
-	secretsList := make([]interface{}, len(resp.Data.Config.SecretsList))
-	for i, v := range resp.Data.Config.SecretsList {
-		s := make(map[string]interface{})
-		mapAddStr(s, "key", v.Key)
-		if len(currentConfig) > 0 {
-			mapAddStr(s, "value", resourceConnectorReadConfigFlattenSecretsListGetStateValue(v.Key, currentConfig))
-		} else {
-			mapAddStr(s, "value", v.Value)
-		}
-		secretsList[i] = s
-	}
-
-	return secretsList
-}
-
-func resourceConnectorReadConfigFlattenProjectCredentialsGetStateValue(project, key string, currentConfig []interface{}) string {
-	result := getSubcollectionElementValue("project_credentials", "project", project, key, currentConfig)
-
-	if result == nil {
-		return ""
-	}
-
-	return result.(string)
-}
-
-func resourceConnectorReadConfigFlattenSecretsListGetStateValue(key string, currentConfig []interface{}) string {
-	result := getSubcollectionElementValue("secrets_list", "key", key, "value", currentConfig)
-
-	if result == nil {
-		return ""
-	}
-
-	return result.(string)
-}
-
-func getSubcollectionElementValue(configKey, subKey, subKeyValue, targetKey string, currentConfig []interface{}) interface{} {
-	targetList := currentConfig[0].(map[string]interface{})[configKey].(*schema.Set).List()
-	for _, v := range targetList {
-		if v.(map[string]interface{})[subKey].(string) == subKeyValue {
-			return v.(map[string]interface{})[targetKey]
-		}
+	return []interface{}{a}, nil
+}
+
+func resourceConnectorReadAuthSecrets(a map[string]interface{}, keys ...string) error {
+	for _, k := range keys {
+		v, err := secretStateValue(a[k].(string))
+		if err != nil {
+			return err
+		}
+		a[k] = v
 	}
 	return nil
 }
-
-func resourceConnectorReadConfigFlattenReports(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
-	if len(resp.Data.Config.Reports) < 1 {
-		return make([]interface{}, 0)
-	}
-
-	reports := make([]interface{}, len(resp.Data.Config.Reports))
-	for i, v := range resp.Data.Config.Reports {
-		r := make(map[string]interface{})
-		mapAddStr(r, "table", v.Table)
-		mapAddStr(r, "config_type", v.ConfigType)
-		mapAddStr(r, "prebuilt_report", v.PrebuiltReport)
-		mapAddStr(r, "report_type", v.ReportType)
-		mapAddXInterface(r, "fields", xStrXInterface(v.Fields))
-		mapAddXInterface(r, "dimensions", xStrXInterface(v.Dimensions))
-		mapAddXInterface(r, "metrics", xStrXInterface(v.Metrics))
-		mapAddXInterface(r, "segments", xStrXInterface(v.Segments))
-		mapAddStr(r, "filter", v.Filter)
-		reports[i] = r
-	}
-
-	return reports
-}
-
-func resourceConnectorReadConfigFlattenAdobeAnalyticsConfigurations(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
-	if len(resp.Data.Config.AdobeAnalyticsConfigurations) < 1 {
-		return make([]interface{}, 0)
-	}
-
-	configurations := make([]interface{}, len(resp.Data.Config.AdobeAnalyticsConfigurations))
-	for i, v := range resp.Data.Config.AdobeAnalyticsConfigurations {
-		c := make(map[string]interface{})
-		mapAddStr(c, "sync_mode", v.SyncMode)
-		mapAddXInterface(c, "metrics", xStrXInterface(v.Metrics))
-		mapAddXInterface(c, "calculated_metrics", xStrXInterface(v.CalculatedMetrics))
-		mapAddXInterface(c, "elements", xStrXInterface(v.Elements))
-		mapAddXInterface(c, "segments", xStrXInterface(v.Segments))
-		mapAddXInterface(c, "report_suites", xStrXInterface(v.ReportSuites))
-		configurations[i] = c
-	}
-
-	return configurations
-}
-
-func resourceConnectorReadConfigFlattenCustomTables(resp *fivetran.ConnectorCustomMergedDetailsResponse) []interface{} {
-	if len(resp.Data.Config.CustomTables) < 1 {
-		return make([]interface{}, 0)
-	}
-
-	customTables := make([]interface{}, len(resp.Data.Config.CustomTables))
-	for i, v := range resp.Data.Config.CustomTables {
-		ct := make(map[string]interface{})
-		mapAddStr(ct, "table_name", v.TableName)
-		mapAddStr(ct, "config_type", v.ConfigType)
-		mapAddXInterface(ct, "fields", xStrXInterface(v.Fields))
-		mapAddXInterface(ct, "breakdowns", xStrXInterface(v.Breakdowns))
-		mapAddXInterface(ct, "action_breakdowns", xStrXInterface(v.ActionBreakdowns))
-		mapAddStr(ct, "aggregation", v.Aggregation)
-		mapAddStr(ct, "action_report_time", v.ActionReportTime)
-		mapAddStr(ct, "click_attribution_window", v.ClickAttributionWindow)
-		mapAddStr(ct, "view_attribution_window", v.ViewAttributionWindow)
-		mapAddStr(ct, "prebuilt_report_name", v.PrebuiltReportName)
-		customTables[i] = ct
-	}
-
-	return customTables
-}
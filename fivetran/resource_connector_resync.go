@@ -0,0 +1,200 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceConnectorResync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceConnectorResyncCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceConnectorResyncRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceConnectorResyncUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceConnectorResyncDelete),
+		Timeouts:      resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id":           {Type: schema.TypeString, Computed: true},
+			"connector_id": {Type: schema.TypeString, Required: true, ForceNew: true},
+			"tables": {Type: schema.TypeSet, Optional: true, ForceNew: true,
+				Elem: &schema.Schema{Type: schema.TypeString, ValidateFunc: resourceConnectorResyncTableValidateFunc},
+			},
+			"triggers":            {Type: schema.TypeMap, Optional: true, ForceNew: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"wait_for_completion": {Type: schema.TypeBool, Optional: true, Default: false},
+			"poll_interval":       {Type: schema.TypeString, Optional: true, Default: "10s", ValidateFunc: providerDurationValidateFunc},
+			"resynced_tables":     {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"is_historical_sync":  {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+func resourceConnectorResyncTableValidateFunc(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	if schemaName, tableName, ok := strings.Cut(v, "."); !ok || schemaName == "" || tableName == "" {
+		errs = append(errs, fmt.Errorf("%q expected a table name in the schema.table format, got: %v", key, v))
+	}
+	return
+}
+
+func resourceConnectorResyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient)
+	connectorID := d.Get("connector_id").(string)
+
+	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "poll_interval error", fmt.Sprint(err))
+	}
+
+	before, err := client.NewConnectorDetails().ConnectorID(connectorID).DoCustomMerged(ctx)
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("%v; code: %v; message: %v", err, before.Code, before.Message))
+	}
+
+	tables := xInterfaceStrXStr(d.Get("tables").(*schema.Set).List())
+	sort.Strings(tables)
+	if len(tables) == 0 {
+		diags = resourceConnectorResyncConnector(ctx, client.rest, connectorID)
+	} else {
+		diags = resourceConnectorResyncTables(ctx, client.Client, d, tables)
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		if diags = resourceConnectorResyncWait(ctx, client.Client, connectorID, &before, pollInterval); diags.HasError() {
+			return diags
+		}
+	}
+
+	// the ID is set once the re-sync succeeded, a failed re-sync isn't kept in the state
+	d.SetId(connectorID)
+
+	resourceConnectorResyncRead(ctx, d, m)
+
+	return diags
+}
+
+// resourceConnectorResyncConnector triggers the historical re-sync of the whole connector. The SDK doesn't
+// implement the connector re-sync endpoint, so it is called by the restClient.
+func resourceConnectorResyncConnector(ctx context.Context, rest *restClient, connectorID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resp, err := rest.do(ctx, http.MethodPost, fmt.Sprintf("/connectors/%v/resync", url.PathEscape(connectorID)), map[string]interface{}{}, http.StatusOK)
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("connector re-sync: %v; code: %v; message: %v", err, resp.Code, resp.Message))
+	}
+
+	return diags
+}
+
+// resourceConnectorResyncTables triggers the re-sync of each table and adds it to resynced_tables. When a re-sync
+// fails, the error lists the tables whose re-sync has already been triggered.
+func resourceConnectorResyncTables(ctx context.Context, client *fivetran.Client, d *schema.ResourceData, tables []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	connectorID := d.Get("connector_id").(string)
+	var triggered []string
+
+	for _, table := range tables {
+		schemaName, tableName, _ := strings.Cut(table, ".")
+		resp, err := client.NewConnectorReSyncTable().ConnectorID(connectorID).Schema(schemaName).Table(tableName).Do(ctx)
+		if err != nil {
+			detail := fmt.Sprintf("table %v re-sync: %v; code: %v; message: %v", table, err, resp.Code, resp.Message)
+			if len(triggered) > 0 {
+				detail += fmt.Sprintf("; the re-sync of tables %v has already been triggered", strings.Join(triggered, ", "))
+			}
+			return newDiagAppend(diags, diag.Error, "create error", detail)
+		}
+
+		triggered = append(triggered, table)
+		if err := d.Set("resynced_tables", triggered); err != nil {
+			return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+		}
+	}
+
+	return diags
+}
+
+// resourceConnectorResyncWait polls the connector details until the historical sync started by the re-sync is
+// finished: is_historical_sync returns to false after it has been true. The syncs that finish in the meantime, e.g.
+// the incremental ones, don't end the wait, so a re-sync finished before the first poll can't be confirmed and the
+// wait fails when the create timeout expires.
+func resourceConnectorResyncWait(ctx context.Context, client *fivetran.Client, connectorID string,
+	before *fivetran.ConnectorCustomMergedDetailsResponse, pollInterval time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+	started := false
+
+	for {
+		resp, err := client.NewConnectorDetails().ConnectorID(connectorID).DoCustomMerged(ctx)
+		if err != nil {
+			return newDiagAppend(diags, diag.Error, "wait for re-sync error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+		}
+
+		if resp.Data.FailedAt.After(before.Data.FailedAt) {
+			return newDiagAppend(diags, diag.Error, "connector re-sync failed",
+				fmt.Sprintf("failed_at: %v; sync_state: %v%v", resp.Data.FailedAt, resp.Data.Status.SyncState, resourceConnectorStatusDetails(&resp)))
+		}
+
+		isHistoricalSync := resp.Data.Status.IsHistoricalSync != nil && *resp.Data.Status.IsHistoricalSync
+		if isHistoricalSync {
+			started = true
+		} else if started {
+			return diags
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return newDiagAppend(diags, diag.Error, "wait for re-sync error",
+				fmt.Sprintf("%v; the historical sync started: %v; is_historical_sync: %v; sync_state: %v", ctx.Err(), started, isHistoricalSync, resp.Data.Status.SyncState))
+		case <-timer.C:
+		}
+	}
+}
+
+func resourceConnectorResyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient).Client
+
+	resp, err := client.NewConnectorDetails().ConnectorID(d.Get("connector_id").(string)).DoCustomMerged(ctx)
+	if err != nil {
+		// If the connector does not exist (404), inform Terraform. We want to immediately
+		// return here to prevent further processing.
+		if resp.Code == "404" {
+			d.SetId("")
+			return nil
+		}
+		return newDiagAppend(diags, diag.Error, "read error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+	}
+
+	if err := d.Set("is_historical_sync", boolPointerToStr(resp.Data.Status.IsHistoricalSync)); err != nil {
+		return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+	}
+
+	return diags
+}
+
+// resourceConnectorResyncUpdate only saves the wait_for_completion and poll_interval values,
+// all the other fields force a new re-sync.
+func resourceConnectorResyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceConnectorResyncRead(ctx, d, m)
+}
+
+// resourceConnectorResyncDelete only removes the resource from the state, a re-sync can't be undone.
+func resourceConnectorResyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
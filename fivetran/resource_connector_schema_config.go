@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"hash/fnv"
+	"strings"
 
 	"github.com/fivetran/go-fivetran"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 const (
@@ -28,6 +30,14 @@ const (
 	ENABLED                = "enabled"
 	HASHED                 = "hashed"
 	SYNC_MODE              = "sync_mode"
+	ON_DESTROY             = "on_destroy"
+
+	KEEP                 = "keep"
+	RESET_TO_SCH_DEFAULT = "reset_to_sch_default"
+	DISABLE_ALL          = "disable_all"
+
+	// DEFAULT_SYNC_MODE is the sync mode of the tables that haven't been configured
+	DEFAULT_SYNC_MODE = SOFT_DELETE
 
 	HANDLED       = "handled"
 	EXCLUDED      = "excluded"
@@ -36,16 +46,22 @@ const (
 
 func resourceSchemaConfig() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceSchemaConfigCreate,
-		ReadContext:   resourceSchemaConfigRead,
-		UpdateContext: resourceSchemaConfigUpdate,
-		DeleteContext: resourceSchemaConfigDelete,
-		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceSchemaConfigCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceSchemaConfigRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceSchemaConfigUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceSchemaConfigDelete),
+		Timeouts:      resourceTimeouts(),
+		Importer:      &schema.ResourceImporter{StateContext: resourceSchemaConfigImport},
+		CustomizeDiff: resourceSchemaConfigCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			ID:                     {Type: schema.TypeString, Computed: true},
 			CONNECTOR_ID:           {Type: schema.TypeString, Required: true, ForceNew: true},
 			SCHEMA_CHANGE_HANDLING: resourceSchemaConfigSchemaShangeHandling(),
 			SCHEMA:                 resourceSchemaConfigSchema(),
+			TABLE_RULE:             resourceSchemaConfigTableRule(),
+			COLUMN_RULE:            resourceSchemaConfigColumnRule(),
+			RULE_MATCHES:           resourceSchemaConfigRuleMatches(),
+			ON_DESTROY:             {Type: schema.TypeString, Optional: true, Default: KEEP, ValidateFunc: validation.StringInSlice([]string{KEEP, RESET_TO_SCH_DEFAULT, DISABLE_ALL}, false)},
 		},
 	}
 }
@@ -111,9 +127,10 @@ func resourceSchemaConfigColumn() *schema.Schema {
 	return &schema.Schema{Type: schema.TypeSet, Optional: true, Set: resourceColumnConfigHash,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
-				NAME:    {Type: schema.TypeString, Required: true},
-				ENABLED: {Type: schema.TypeString, Optional: true, Default: "true", ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
-				HASHED:  {Type: schema.TypeString, Optional: true, Default: "false", ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
+				NAME:           {Type: schema.TypeString, Required: true},
+				ENABLED:        {Type: schema.TypeString, Optional: true, Default: "true", ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
+				HASHED:         {Type: schema.TypeString, Optional: true, Default: "false", ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
+				IS_PRIMARY_KEY: {Type: schema.TypeString, Optional: true, ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
 			},
 		},
 	}
@@ -122,11 +139,11 @@ func resourceSchemaConfigColumn() *schema.Schema {
 func resourceSchemaConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	connectorID := d.Get(CONNECTOR_ID).(string)
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient)
 	var schemaChangeHandling = d.Get(SCHEMA_CHANGE_HANDLING).(string)
 
 	// ensure connector has standard config with schema reloaded
-	upstreamSchema, schemaDiags := getUpstreamConfigResponse(client, ctx, connectorID, "create")
+	upstreamSchema, schemaDiags := getUpstreamConfigResponse(client.Client, ctx, connectorID, "create")
 	if upstreamSchema == nil {
 		return schemaDiags
 	}
@@ -145,9 +162,14 @@ func resourceSchemaConfigCreate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
+	rules, err := readSchemaConfigRules(d.Get(TABLE_RULE).([]interface{}), d.Get(COLUMN_RULE).([]interface{}))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprint(err))
+	}
+
 	// apply schema config
 	applyDiags, ok := applyLocalSchemaConfig(
-		d.Get(SCHEMA).(*schema.Set).List(),
+		d.Get(SCHEMA).(*schema.Set).List(), rules,
 		connectorID, schemaChangeHandling,
 		"create error",
 		ctx, client, upstreamSchema)
@@ -162,28 +184,46 @@ func resourceSchemaConfigCreate(ctx context.Context, d *schema.ResourceData, m i
 
 func resourceSchemaConfigRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient)
 	connectorID := d.Get(ID).(string)
 
-	schemaResponse, getDiags := getUpstreamConfigResponse(client, ctx, connectorID, "read error")
+	schemaResponse, getDiags := getUpstreamConfigResponse(client.Client, ctx, connectorID, "read error")
 	if schemaResponse == nil {
 		return getDiags
 	}
 
-	// exclude all items that are consistent with SCH policy
-	alignedConfig := excludeConfigBySCH(
-		readUpstreamConfig(schemaResponse),
+	rules, err := readSchemaConfigRules(d.Get(TABLE_RULE).([]interface{}), d.Get(COLUMN_RULE).([]interface{}))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "read error", fmt.Sprint(err))
+	}
+
+	// exclude all items that are consistent with SCH policy and the rules
+	upstreamConfig := readUpstreamConfig(schemaResponse)
+	upstreamSyncModes := readUpstreamSyncModes(schemaResponse)
+	alignedConfig := excludeConfigByRules(
+		excludeConfigBySCH(upstreamConfig, schemaResponse.Data.SchemaChangeHandling),
+		upstreamSyncModes, rules,
 		schemaResponse.Data.SchemaChangeHandling)
 
 	// if local schema config aligned to SCH policy we need to include it to state to avoid drifts
 	if ls, ok := d.GetOk(SCHEMA); ok {
-		s, _ := includeLocalConfiguredSchemas(alignedConfig[SCHEMA].(map[string]interface{}), mapSchemas(ls.(*schema.Set).List()))
-		alignedConfig[SCHEMA] = s
+		localSchemas := mapSchemas(ls.(*schema.Set).List())
+		s, _ := includeLocalConfiguredSchemas(alignedConfig[SCHEMA].(map[string]interface{}), localSchemas)
+		alignedConfig[SCHEMA] = includeUpstreamSyncModes(s, localSchemas, upstreamSyncModes)
+
+		if hasSchemaConfigSettings(localSchemas) {
+			settings, err := readUpstreamSchemaConfigSettings(ctx, client.rest, connectorID)
+			if err != nil {
+				return newDiagAppend(diags, diag.Error, "read error", fmt.Sprint(err))
+			}
+			alignedConfig[SCHEMA] = includeUpstreamSettings(alignedConfig[SCHEMA].(map[string]interface{}), localSchemas, settings)
+		}
 	}
 
 	// transform config to flat sets
 	flatConfig := flattenConfig(removeExcludedSchemas(alignedConfig))
 	flatConfig[CONNECTOR_ID] = connectorID
+	flatConfig[RULE_MATCHES] = schemaConfigRuleMatches(upstreamConfig[SCHEMA].(map[string]interface{}), rules)
 
 	// set state
 	for k, v := range flatConfig {
@@ -197,10 +237,57 @@ func resourceSchemaConfigRead(ctx context.Context, d *schema.ResourceData, m int
 	return diags
 }
 
+// resourceSchemaConfigImport imports the schema config of a connector by the connector ID. The local schema config
+// is reconstructed from the upstream items that aren't aligned with the SCH policy and the tables with a sync mode
+// other than the default one, so the plan after the import has no changes when the configuration matches upstream.
+func resourceSchemaConfigImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*ProviderClient)
+	connectorID := d.Id()
+
+	schemaResponse, getDiags := getUpstreamConfigResponse(client.Client, ctx, connectorID, "import error")
+	if schemaResponse == nil {
+		return nil, fmt.Errorf("%v: %v", getDiags[0].Summary, getDiags[0].Detail)
+	}
+
+	upstreamConfig := readUpstreamConfig(schemaResponse)
+	upstreamSchemas := upstreamConfig[SCHEMA].(map[string]interface{})
+	localSchemas := removeExcludedSchemas(excludeConfigBySCH(upstreamConfig, schemaResponse.Data.SchemaChangeHandling))[SCHEMA].(map[string]interface{})
+
+	for sname, tables := range readUpstreamSyncModes(schemaResponse) {
+		for tname, syncMode := range tables {
+			if syncMode == DEFAULT_SYNC_MODE {
+				continue
+			}
+			if _, ok := localSchemas[sname]; !ok {
+				localSchemas[sname] = copyMap(upstreamSchemas[sname].(map[string]interface{}))
+				localSchemas[sname].(map[string]interface{})[TABLE] = make(map[string]interface{})
+			}
+			ltables := localSchemas[sname].(map[string]interface{})[TABLE].(map[string]interface{})
+			if _, ok := ltables[tname]; !ok {
+				ltables[tname] = copyMap(upstreamSchemas[sname].(map[string]interface{})[TABLE].(map[string]interface{})[tname].(map[string]interface{}))
+				ltables[tname].(map[string]interface{})[COLUMN] = make(map[string]interface{})
+			}
+			ltables[tname].(map[string]interface{})[SYNC_MODE] = syncMode
+		}
+	}
+
+	if err := d.Set(CONNECTOR_ID, connectorID); err != nil {
+		return nil, err
+	}
+	if err := d.Set(SCHEMA, flattenSchemas(localSchemas)); err != nil {
+		return nil, err
+	}
+	if err := d.Set(ON_DESTROY, KEEP); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceSchemaConfigUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	connectorID := d.Get(ID).(string)
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient)
 	var schemaChangeHandling = d.Get(SCHEMA_CHANGE_HANDLING).(string)
 	var upstreamSchema *fivetran.ConnectorSchemaDetailsResponse
 
@@ -220,9 +307,14 @@ func resourceSchemaConfigUpdate(ctx context.Context, d *schema.ResourceData, m i
 		}
 	}
 
+	rules, err := readSchemaConfigRules(d.Get(TABLE_RULE).([]interface{}), d.Get(COLUMN_RULE).([]interface{}))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "update error", fmt.Sprint(err))
+	}
+
 	// apply schema config
 	applyDiags, ok := applyLocalSchemaConfig(
-		d.Get(SCHEMA).(*schema.Set).List(),
+		d.Get(SCHEMA).(*schema.Set).List(), rules,
 		connectorID, schemaChangeHandling,
 		"update error",
 		ctx, client, upstreamSchema)
@@ -233,40 +325,102 @@ func resourceSchemaConfigUpdate(ctx context.Context, d *schema.ResourceData, m i
 	return resourceSchemaConfigRead(ctx, d, m)
 }
 
+// resourceSchemaConfigDelete restores the schema config according to on_destroy. The schema settings can't be deleted,
+// with keep they stay as they are.
 func resourceSchemaConfigDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	// do nothing - we can't delete schema settings
+	onDestroy := d.Get(ON_DESTROY).(string)
+	if onDestroy == KEEP {
+		return diags
+	}
+
+	client := m.(*ProviderClient)
+	connectorID := d.Get(CONNECTOR_ID).(string)
+
+	schemaResponse, err := client.NewConnectorSchemaDetails().ConnectorID(connectorID).Do(ctx)
+	if err != nil {
+		// there is nothing to restore when the connector or its schema config doesn't exist
+		if strings.HasPrefix(schemaResponse.Code, "NotFound_") {
+			return diags
+		}
+		return newDiagAppend(diags, diag.Error, "delete error", fmt.Sprintf("%v; code: %v; message: %v", err, schemaResponse.Code, schemaResponse.Message))
+	}
+
+	if onDestroy == DISABLE_ALL {
+		config := make(map[string]interface{})
+		config[SCHEMA] = disableAllSchemas(readUpstreamConfig(&schemaResponse)[SCHEMA].(map[string]interface{}))
+		diags, _ = updateSchemaConfig(config, connectorID, "delete error", ctx, client)
+		return diags
+	}
+
+	// without the local schema config and the rules, all the upstream items are patched to the SCH policy default
+	diags, _ = applyLocalSchemaConfig(nil, schemaConfigRules{}, connectorID, schemaResponse.Data.SchemaChangeHandling,
+		"delete error", ctx, client, &schemaResponse)
 	return diags
 }
 
+// disableAllSchemas returns the config patch that disables all the upstream schemas and their tables, the locked
+// tables are skipped by createUpdateTableConfigRequest
+func disableAllSchemas(upstreamSchemas map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for sname, s := range upstreamSchemas {
+		tables := make(map[string]interface{})
+		if utables, ok := s.(map[string]interface{})[TABLE].(map[string]interface{}); ok {
+			for tname, t := range utables {
+				tables[tname] = map[string]interface{}{
+					ENABLED:       "false",
+					PATCH_ALLOWED: t.(map[string]interface{})[PATCH_ALLOWED],
+				}
+			}
+		}
+		result[sname] = map[string]interface{}{ENABLED: "false", TABLE: tables}
+	}
+	return result
+}
+
 func applyLocalSchemaConfig(
 	localSchemas []interface{},
+	rules schemaConfigRules,
 	connectorID, sch, errorMessage string,
 	ctx context.Context,
-	client *fivetran.Client,
+	client *ProviderClient,
 	upstreamSchemaResponse *fivetran.ConnectorSchemaDetailsResponse) (diag.Diagnostics, bool) {
-	var diags diag.Diagnostics
 	schemaResponse := upstreamSchemaResponse
 	if schemaResponse == nil {
 		// read upstream schema config
-		upstreamResponse, getDiags := getUpstreamConfigResponse(client, ctx, connectorID, errorMessage)
+		upstreamResponse, getDiags := getUpstreamConfigResponse(client.Client, ctx, connectorID, errorMessage)
 		if upstreamResponse == nil {
 			return getDiags, false
 		}
 		schemaResponse = upstreamResponse
 	}
 
-	// prepare config patch
-	var alignedConfig = excludeConfigBySCH(readUpstreamConfig(schemaResponse), sch)
+	// prepare config patch, the rules are expanded to the local schema config of the matching upstream items
+	upstreamConfig := readUpstreamConfig(schemaResponse)
+	var alignedConfig = excludeConfigBySCH(upstreamConfig, sch)
 	config := make(map[string]interface{})
 	config[SCHEMA] = applyConfigOnAlignedUpstreamConfig(
 		alignedConfig[SCHEMA].(map[string]interface{}),
-		mapSchemas(localSchemas),
+		expandSchemaConfigRules(upstreamConfig[SCHEMA].(map[string]interface{}), mapSchemas(localSchemas), rules),
 		sch)
-	configPatch := removeExcludedSchemas(config)
+	return updateSchemaConfig(removeExcludedSchemas(config), connectorID, errorMessage, ctx, client)
+}
 
-	// convert patch into request
+// updateSchemaConfig converts the config patch into the schema config update request and sends it, an empty patch
+// isn't sent. A patch with the settings the SDK doesn't implement is sent as a single raw request.
+func updateSchemaConfig(
+	configPatch map[string]interface{},
+	connectorID, errorMessage string,
+	ctx context.Context,
+	client *ProviderClient) (diag.Diagnostics, bool) {
+	var diags diag.Diagnostics
 	if schemas, ok := configPatch[SCHEMA].(map[string]interface{}); ok && len(schemas) > 0 {
+		if hasSchemaConfigSettings(schemas) {
+			if err := updateSchemaConfigSettings(ctx, client.rest, connectorID, schemas); err != nil {
+				return newDiagAppend(diags, diag.Error, errorMessage, fmt.Sprint(err)), false
+			}
+			return diags, true
+		}
 		svc := client.NewConnectorSchemaUpdateService().ConnectorID(connectorID)
 		for sname, s := range schemas {
 			srequest, _ := createUpdateSchemaConfigRequest(s.(map[string]interface{}))
@@ -346,6 +500,27 @@ func includeLocalConfiguredColumns(upstream, local map[string]interface{}) (map[
 	return result, diags
 }
 
+// includeUpstreamSyncModes sets the upstream sync modes to the tables of schemas that have a sync mode in local, a
+// sync mode changed outside of Terraform is reported as a drift.
+func includeUpstreamSyncModes(schemas, local map[string]interface{}, syncModes map[string]map[string]string) map[string]interface{} {
+	for sname, ls := range local {
+		s, ok := schemas[sname].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ltables, _ := ls.(map[string]interface{})[TABLE].(map[string]interface{})
+		tables, _ := s[TABLE].(map[string]interface{})
+		for tname, lt := range ltables {
+			t, ok := tables[tname].(map[string]interface{})
+			if !ok || !hasSyncMode(lt.(map[string]interface{})) {
+				continue
+			}
+			t[SYNC_MODE] = syncModes[sname][tname]
+		}
+	}
+	return schemas
+}
+
 func createUpdateSchemaConfigRequest(schemaConfig map[string]interface{}) (*fivetran.ConnectorSchemaConfigSchema, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	result := fivetran.NewConnectorSchemaConfigSchema()
@@ -542,6 +717,12 @@ func applyColumnConfig(alignedColumn map[string]interface{}, localColumn map[str
 		}
 		result[HASHED] = localColumn[HASHED]
 	}
+	if lis_primary_key, ok := localColumn[IS_PRIMARY_KEY].(string); ok && lis_primary_key != "" && !isLocked(alignedColumn) {
+		if ris_primary_key, ok := result[IS_PRIMARY_KEY].(string); !ok || ris_primary_key != lis_primary_key {
+			needInclude = true
+		}
+		result[IS_PRIMARY_KEY] = lis_primary_key
+	}
 	if needInclude {
 		return include(result)
 	}
@@ -628,6 +809,9 @@ func mapColumns(columns []interface{}) map[string]interface{} {
 		if hashed, ok := cmap[HASHED].(string); ok && hashed != "" {
 			rcolumn[HASHED] = hashed
 		}
+		if is_primary_key, ok := cmap[IS_PRIMARY_KEY].(string); ok && is_primary_key != "" {
+			rcolumn[IS_PRIMARY_KEY] = is_primary_key
+		}
 
 		result[cname] = rcolumn
 	}
@@ -692,6 +876,9 @@ func flattenColumns(columns map[string]interface{}) []interface{} {
 		if hashed, ok := vmap[HASHED].(string); ok && hashed != "" {
 			c[HASHED] = hashed
 		}
+		if is_primary_key, ok := vmap[IS_PRIMARY_KEY].(string); ok && is_primary_key != "" {
+			c[IS_PRIMARY_KEY] = is_primary_key
+		}
 		result = append(result, c)
 	}
 	return result
@@ -852,6 +1039,23 @@ func readUpstreamConfig(response *fivetran.ConnectorSchemaDetailsResponse) map[s
 	return result
 }
 
+// readUpstreamSyncModes returns the upstream sync modes of the tables by schema and table names
+func readUpstreamSyncModes(response *fivetran.ConnectorSchemaDetailsResponse) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	for sname, schema := range response.Data.Schemas {
+		for tname, table := range schema.Tables {
+			if table.SyncMode == nil || *table.SyncMode == "" {
+				continue
+			}
+			if _, ok := result[sname]; !ok {
+				result[sname] = make(map[string]string)
+			}
+			result[sname][tname] = *table.SyncMode
+		}
+	}
+	return result
+}
+
 func readUpstreamSchema(schemaResponse *fivetran.ConnectorSchemaConfigSchemaResponse) map[string]interface{} {
 	result := make(map[string]interface{})
 	result[ENABLED] = boolPointerToStr(schemaResponse.Enabled)
@@ -932,6 +1136,9 @@ func resourceColumnConfigHash(v interface{}) int {
 	}
 
 	var hashKey = vmap[NAME].(string) + vmap[ENABLED].(string) + hashed
+	if is_primary_key, ok := vmap[IS_PRIMARY_KEY].(string); ok && is_primary_key != "" {
+		hashKey = hashKey + is_primary_key
+	}
 
 	h.Write([]byte(hashKey))
 	return int(h.Sum32())
@@ -0,0 +1,366 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	TABLE_RULE   = "table_rule"
+	COLUMN_RULE  = "column_rule"
+	MATCH        = "match"
+	MATCH_TYPE   = "match_type"
+	RULE_MATCHES = "rule_matches"
+	RULE         = "rule"
+	MATCHES      = "matches"
+	MATCH_COUNT  = "match_count"
+
+	GLOB  = "glob"
+	REGEX = "regex"
+)
+
+// The table_rule and column_rule blocks set the fields of the upstream tables and columns matching a pattern. The
+// rules are expanded to the schema config entries of the matching items against the upstream schema config, a
+// later rule overrides the fields set by an earlier one and the fields set by the schema config entries override the
+// rules. The tables are matched by "<schema>.<table>" and the columns by "<schema>.<table>.<column>". A glob pattern
+// is matched segment by segment, so "*" doesn't match a dot and the pattern must have a segment for each part of
+// the name. A regular expression is matched against the whole dot separated name.
+
+func resourceSchemaConfigRule(fields map[string]*schema.Schema) *schema.Schema {
+	s := map[string]*schema.Schema{
+		MATCH:      {Type: schema.TypeString, Required: true},
+		MATCH_TYPE: {Type: schema.TypeString, Optional: true, Default: GLOB, ValidateFunc: validation.StringInSlice([]string{GLOB, REGEX}, false)},
+	}
+	for k, v := range fields {
+		s[k] = v
+	}
+	return &schema.Schema{Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: s}}
+}
+
+func resourceSchemaConfigTableRule() *schema.Schema {
+	return resourceSchemaConfigRule(map[string]*schema.Schema{
+		ENABLED:   {Type: schema.TypeString, Optional: true, ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
+		SYNC_MODE: resourceSchemaConfigSyncMode(),
+	})
+}
+
+func resourceSchemaConfigColumnRule() *schema.Schema {
+	return resourceSchemaConfigRule(map[string]*schema.Schema{
+		ENABLED: {Type: schema.TypeString, Optional: true, ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
+		HASHED:  {Type: schema.TypeString, Optional: true, ValidateFunc: resourceSchemaConfigBooleanValidateFunc},
+	})
+}
+
+func resourceSchemaConfigRuleMatches() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				RULE:        {Type: schema.TypeString, Computed: true},
+				MATCHES:     {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				MATCH_COUNT: {Type: schema.TypeInt, Computed: true},
+			},
+		},
+	}
+}
+
+// schemaConfigRule is a table_rule or column_rule block
+type schemaConfigRule struct {
+	// key is the rule address, e.g. "table_rule.0"
+	key string
+	// fields are the schema config fields set by the rule
+	fields map[string]interface{}
+	// match reports whether the rule matches the name parts, e.g. the schema and the table names of a table
+	match func(names ...string) bool
+}
+
+type schemaConfigRules struct {
+	tables  []schemaConfigRule
+	columns []schemaConfigRule
+}
+
+func (r schemaConfigRules) empty() bool {
+	return len(r.tables) == 0 && len(r.columns) == 0
+}
+
+// readSchemaConfigRules returns the table_rule and column_rule blocks, the invalid patterns are errors
+func readSchemaConfigRules(tableRules, columnRules []interface{}) (schemaConfigRules, error) {
+	var result schemaConfigRules
+	var err error
+	if result.tables, err = readSchemaConfigRuleList(TABLE_RULE, 2, tableRules, ENABLED, SYNC_MODE); err != nil {
+		return result, err
+	}
+	result.columns, err = readSchemaConfigRuleList(COLUMN_RULE, 3, columnRules, ENABLED, HASHED)
+	return result, err
+}
+
+// readSchemaConfigRuleList returns the rules of kind, the names they match have segments parts: 2 for the tables,
+// "<schema>.<table>", and 3 for the columns, "<schema>.<table>.<column>". A glob pattern with another number of dot
+// separated segments is an error.
+func readSchemaConfigRuleList(kind string, segments int, rules []interface{}, fields ...string) ([]schemaConfigRule, error) {
+	result := make([]schemaConfigRule, 0, len(rules))
+	for i, r := range rules {
+		rmap, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rule := schemaConfigRule{key: fmt.Sprintf("%v.%v", kind, i), fields: make(map[string]interface{})}
+		for _, k := range fields {
+			if v, ok := rmap[k].(string); ok && v != "" {
+				rule.fields[k] = v
+			}
+		}
+		pattern := rmap[MATCH].(string)
+		if rmap[MATCH_TYPE] == REGEX {
+			re, err := regexp.Compile("^(?:" + pattern + ")$")
+			if err != nil {
+				return nil, fmt.Errorf("%v.%v: invalid regular expression %q: %v", rule.key, MATCH, pattern, err)
+			}
+			rule.match = func(names ...string) bool {
+				return re.MatchString(strings.Join(names, "."))
+			}
+		} else {
+			patterns := strings.Split(pattern, ".")
+			if len(patterns) != segments {
+				return nil, fmt.Errorf("%v.%v: the glob pattern %q must have %v dot separated segments", rule.key, MATCH, pattern, segments)
+			}
+			for _, p := range patterns {
+				if _, err := path.Match(p, ""); err != nil {
+					return nil, fmt.Errorf("%v.%v: invalid glob pattern %q: %v", rule.key, MATCH, pattern, err)
+				}
+			}
+			rule.match = func(names ...string) bool {
+				for i, p := range patterns {
+					if matched, _ := path.Match(p, names[i]); !matched {
+						return false
+					}
+				}
+				return true
+			}
+		}
+		result = append(result, rule)
+	}
+	return result, nil
+}
+
+// schemaConfigRuleFields returns the fields set by the rules matching the name parts, in the rules order
+func schemaConfigRuleFields(rules []schemaConfigRule, names ...string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for _, rule := range rules {
+		if rule.match(names...) {
+			for k, v := range rule.fields {
+				result[k] = v
+			}
+		}
+	}
+	return result
+}
+
+// expandSchemaConfigRules adds the fields set by the rules to the local schema config entries of the matching
+// upstream tables and columns, the fields set in the local schema config are kept
+func expandSchemaConfigRules(upstreamSchemas, localSchemas map[string]interface{}, rules schemaConfigRules) map[string]interface{} {
+	result := copyMapDeep(localSchemas)
+	if rules.empty() {
+		return result
+	}
+	for sname, s := range upstreamSchemas {
+		tables, _ := s.(map[string]interface{})[TABLE].(map[string]interface{})
+		for tname, t := range tables {
+			if fields := schemaConfigRuleFields(rules.tables, sname, tname); len(fields) > 0 {
+				mergeMissingFields(expandedTable(result, sname, tname), fields)
+			}
+			columns, _ := t.(map[string]interface{})[COLUMN].(map[string]interface{})
+			for cname := range columns {
+				if fields := schemaConfigRuleFields(rules.columns, sname, tname, cname); len(fields) > 0 {
+					mergeMissingFields(expandedItem(expandedTable(result, sname, tname), COLUMN, cname), fields)
+				}
+			}
+		}
+	}
+	return result
+}
+
+func expandedTable(schemas map[string]interface{}, sname, tname string) map[string]interface{} {
+	s, ok := schemas[sname].(map[string]interface{})
+	if !ok {
+		s = make(map[string]interface{})
+		schemas[sname] = s
+	}
+	return expandedItem(s, TABLE, tname)
+}
+
+func expandedItem(parent map[string]interface{}, key, name string) map[string]interface{} {
+	items, ok := parent[key].(map[string]interface{})
+	if !ok {
+		items = make(map[string]interface{})
+		parent[key] = items
+	}
+	item, ok := items[name].(map[string]interface{})
+	if !ok {
+		item = make(map[string]interface{})
+		items[name] = item
+	}
+	return item
+}
+
+func mergeMissingFields(item, fields map[string]interface{}) {
+	for k, v := range fields {
+		if current, ok := item[k].(string); !ok || current == "" {
+			item[k] = v
+		}
+	}
+}
+
+// excludeConfigByRules excludes the upstream tables and columns that match the rules, so they aren't kept in the
+// state and don't cause drift. The items that don't match the rule fields upstream are kept, so the plan applies
+// the rules again.
+func excludeConfigByRules(config map[string]interface{}, syncModes map[string]map[string]string, rules schemaConfigRules, sch string) map[string]interface{} {
+	if rules.empty() {
+		return config
+	}
+	schemas, ok := config[SCHEMA].(map[string]interface{})
+	if !ok {
+		return config
+	}
+	for sname, s := range schemas {
+		smap := s.(map[string]interface{})
+		tables, _ := smap[TABLE].(map[string]interface{})
+		includedTablesCount := 0
+		for tname, t := range tables {
+			tmap := t.(map[string]interface{})
+			columns, _ := tmap[COLUMN].(map[string]interface{})
+			includedColumnsCount := 0
+			for cname, c := range columns {
+				cmap := c.(map[string]interface{})
+				fields := schemaConfigRuleFields(rules.columns, sname, tname, cname)
+				cmap[EXCLUDED] = isLocked(cmap) || columnMatchesRuleFields(cmap, fields, sch)
+				if !isExcluded(cmap) {
+					includedColumnsCount++
+				}
+			}
+			fields := schemaConfigRuleFields(rules.tables, sname, tname)
+			tmap[EXCLUDED] = includedColumnsCount == 0 && tableMatchesRuleFields(tmap, fields, syncModes[sname][tname], sch)
+			if !isExcluded(tmap) {
+				includedTablesCount++
+			}
+		}
+		smap[EXCLUDED] = includedTablesCount == 0 && schemaEnabledAlignToSCH(smap[ENABLED].(string), sch)
+	}
+	return config
+}
+
+// tableMatchesRuleFields reports whether the upstream table has the rule fields, the enabled field of the tables
+// without rules is aligned to the SCH policy
+func tableMatchesRuleFields(table, fields map[string]interface{}, syncMode, sch string) bool {
+	if enabled, ok := fields[ENABLED]; ok {
+		if !isLocked(table) && table[ENABLED] != enabled {
+			return false
+		}
+	} else if !tableEnabledAlignToSCH(table[ENABLED].(string), sch) && !isLocked(table) {
+		return false
+	}
+	if v, ok := fields[SYNC_MODE]; ok && v != syncMode {
+		return false
+	}
+	return true
+}
+
+// columnMatchesRuleFields reports whether the upstream column has the rule fields, the enabled field of the
+// columns without rules is aligned to the SCH policy and they aren't hashed
+func columnMatchesRuleFields(column, fields map[string]interface{}, sch string) bool {
+	if enabled, ok := fields[ENABLED]; ok {
+		if column[ENABLED] != enabled {
+			return false
+		}
+	} else if !columnEnabledAlignToSCH(column[ENABLED].(string), sch) {
+		return false
+	}
+	if hashed, ok := fields[HASHED]; ok {
+		return boolToStr(isHashed(column)) == hashed
+	}
+	return !isHashed(column)
+}
+
+// schemaConfigRuleMatches returns the sorted names and the number of the upstream tables and columns matched by each
+// rule in the data type accepted by the "rule_matches" list
+func schemaConfigRuleMatches(upstreamSchemas map[string]interface{}, rules schemaConfigRules) []interface{} {
+	result := make([]interface{}, 0, len(rules.tables)+len(rules.columns))
+	for _, rule := range rules.tables {
+		result = append(result, schemaConfigRuleMatchesItem(rule, upstreamSchemas, false))
+	}
+	for _, rule := range rules.columns {
+		result = append(result, schemaConfigRuleMatchesItem(rule, upstreamSchemas, true))
+	}
+	return result
+}
+
+func schemaConfigRuleMatchesItem(rule schemaConfigRule, upstreamSchemas map[string]interface{}, columns bool) map[string]interface{} {
+	var names []string
+	for sname, s := range upstreamSchemas {
+		tables, _ := s.(map[string]interface{})[TABLE].(map[string]interface{})
+		for tname, t := range tables {
+			if !columns {
+				if rule.match(sname, tname) {
+					names = append(names, sname+"."+tname)
+				}
+				continue
+			}
+			tcolumns, _ := t.(map[string]interface{})[COLUMN].(map[string]interface{})
+			for cname := range tcolumns {
+				if rule.match(sname, tname, cname) {
+					names = append(names, sname+"."+tname+"."+cname)
+				}
+			}
+		}
+	}
+	sort.Strings(names)
+	matches := make([]interface{}, len(names))
+	for i, v := range names {
+		matches[i] = v
+	}
+	return map[string]interface{}{RULE: rule.key, MATCHES: matches, MATCH_COUNT: len(names)}
+}
+
+// resourceSchemaConfigCustomizeDiff validates the rules and the primary key columns, and shows the names and the
+// number of the upstream tables and columns matched by each rule in the plan. The matches are unknown until the
+// connector ID is known or the connector schema config is available.
+func resourceSchemaConfigCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if err := validateSchemaConfigSettings(mapSchemas(d.Get(SCHEMA).(*schema.Set).List())); err != nil {
+		return err
+	}
+
+	rules, err := readSchemaConfigRules(d.Get(TABLE_RULE).([]interface{}), d.Get(COLUMN_RULE).([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	var matches []interface{}
+	if !rules.empty() {
+		if !d.NewValueKnown(CONNECTOR_ID) || !d.NewValueKnown(TABLE_RULE) || !d.NewValueKnown(COLUMN_RULE) {
+			return d.SetNewComputed(RULE_MATCHES)
+		}
+		// the schema config isn't reloaded in the plan, see getUpstreamConfigResponse
+		resp, err := m.(*ProviderClient).Client.NewConnectorSchemaDetails().ConnectorID(d.Get(CONNECTOR_ID).(string)).Do(ctx)
+		if err != nil {
+			// the schema config of a new connector is loaded on apply
+			if resp.Code == "NotFound_SchemaConfig" {
+				return d.SetNewComputed(RULE_MATCHES)
+			}
+			return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+		}
+		matches = schemaConfigRuleMatches(readUpstreamConfig(&resp)[SCHEMA].(map[string]interface{}), rules)
+	}
+
+	current := d.Get(RULE_MATCHES).([]interface{})
+	if len(matches) == 0 && len(current) == 0 || reflect.DeepEqual(matches, current) {
+		return nil
+	}
+	return d.SetNew(RULE_MATCHES, matches)
+}
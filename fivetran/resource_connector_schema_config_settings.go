@@ -0,0 +1,181 @@
+package fivetran
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	IS_PRIMARY_KEY = "is_primary_key"
+)
+
+// The column is_primary_key setting isn't implemented by the SDK. A schema config patch with primary key settings is
+// sent as a single raw PATCH request, the SDK request with the settings added, so the schema config and its settings
+// are applied together. The settings are read back from the raw schema config response. The raw requests are only
+// sent when the local schema config has the settings.
+
+// schemaConfigSettingsResponse is the part of the schema config response with the settings the SDK doesn't implement
+type schemaConfigSettingsResponse struct {
+	Schemas map[string]struct {
+		Tables map[string]struct {
+			Columns map[string]struct {
+				IsPrimaryKey *bool `json:"is_primary_key"`
+			} `json:"columns"`
+		} `json:"tables"`
+	} `json:"schemas"`
+}
+
+func schemaConfigSettingsPath(connectorID string) string {
+	return fmt.Sprintf("/connectors/%v/schemas", url.PathEscape(connectorID))
+}
+
+// hasSchemaConfigSettings reports whether the schema config has columns with the settings
+func hasSchemaConfigSettings(schemas map[string]interface{}) bool {
+	for _, s := range schemas {
+		tables, _ := s.(map[string]interface{})[TABLE].(map[string]interface{})
+		for _, t := range tables {
+			columns, _ := t.(map[string]interface{})[COLUMN].(map[string]interface{})
+			for _, c := range columns {
+				if v, ok := c.(map[string]interface{})[IS_PRIMARY_KEY].(string); ok && v != "" {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// createUpdateSchemaConfigSettingsRequest returns the raw request of the schema config patch, it has the fields of
+// createUpdateSchemaConfigRequest and the settings
+func createUpdateSchemaConfigSettingsRequest(schemaConfig map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if enabled, ok := schemaConfig[ENABLED].(string); ok && enabled != "" {
+		result["enabled"] = strToBool(enabled)
+	}
+	if tables, ok := schemaConfig[TABLE]; ok && len(tables.(map[string]interface{})) > 0 {
+		rtables := make(map[string]interface{})
+		for tname, table := range tables.(map[string]interface{}) {
+			rtables[tname] = createUpdateTableSettingsRequest(table.(map[string]interface{}))
+		}
+		result["tables"] = rtables
+	}
+	return result
+}
+
+func createUpdateTableSettingsRequest(tableConfig map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if enabled, ok := tableConfig[ENABLED].(string); ok && enabled != "" && !isLocked(tableConfig) {
+		result["enabled"] = strToBool(enabled)
+	}
+	if sync_mode, ok := tableConfig[SYNC_MODE].(string); ok && sync_mode != "" {
+		result["sync_mode"] = sync_mode
+	}
+	if columns, ok := tableConfig[COLUMN]; ok && len(columns.(map[string]interface{})) > 0 {
+		rcolumns := make(map[string]interface{})
+		for cname, column := range columns.(map[string]interface{}) {
+			rcolumns[cname] = createUpdateColumnSettingsRequest(column.(map[string]interface{}))
+		}
+		result["columns"] = rcolumns
+	}
+	return result
+}
+
+func createUpdateColumnSettingsRequest(columnConfig map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	if enabled, ok := columnConfig[ENABLED].(string); ok && enabled != "" && !isLocked(columnConfig) {
+		result["enabled"] = strToBool(enabled)
+	}
+	if hashed, ok := columnConfig[HASHED].(string); ok && hashed != "" && !isLocked(columnConfig) {
+		result["hashed"] = strToBool(hashed)
+	}
+	if is_primary_key, ok := columnConfig[IS_PRIMARY_KEY].(string); ok && is_primary_key != "" && !isLocked(columnConfig) {
+		result[IS_PRIMARY_KEY] = strToBool(is_primary_key)
+	}
+	return result
+}
+
+// updateSchemaConfigSettings sends the schema config patch with its settings in a single raw request, to the path of
+// the SDK request
+func updateSchemaConfigSettings(ctx context.Context, rest *restClient, connectorID string, schemas map[string]interface{}) error {
+	request := make(map[string]interface{})
+	for sname, s := range schemas {
+		request[sname] = createUpdateSchemaConfigSettingsRequest(s.(map[string]interface{}))
+	}
+	resp, err := rest.do(ctx, http.MethodPatch, schemaConfigSettingsPath(connectorID)+"/", map[string]interface{}{"schemas": request}, http.StatusOK)
+	if err != nil {
+		return fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	return nil
+}
+
+// readUpstreamSchemaConfigSettings returns the settings of the upstream schema config
+func readUpstreamSchemaConfigSettings(ctx context.Context, rest *restClient, connectorID string) (*schemaConfigSettingsResponse, error) {
+	resp, err := rest.do(ctx, http.MethodGet, schemaConfigSettingsPath(connectorID), nil, http.StatusOK)
+	if err != nil {
+		return nil, fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+	var result schemaConfigSettingsResponse
+	if err := json.Unmarshal(resp.Data, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// includeUpstreamSettings sets the upstream settings of the columns with the settings configured locally, the
+// settings of the other columns aren't tracked. A setting missing upstream is left out of the state, so the plan
+// shows the local value as a change.
+func includeUpstreamSettings(schemas, local map[string]interface{}, settings *schemaConfigSettingsResponse) map[string]interface{} {
+	for sname, ls := range local {
+		s, ok := schemas[sname].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ltables, _ := ls.(map[string]interface{})[TABLE].(map[string]interface{})
+		tables, _ := s[TABLE].(map[string]interface{})
+		for tname, lt := range ltables {
+			t, ok := tables[tname].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			upstreamColumns := settings.Schemas[sname].Tables[tname].Columns
+			lcolumns, _ := lt.(map[string]interface{})[COLUMN].(map[string]interface{})
+			columns, _ := t[COLUMN].(map[string]interface{})
+			for cname, lc := range lcolumns {
+				c, ok := columns[cname].(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if v, ok := lc.(map[string]interface{})[IS_PRIMARY_KEY].(string); ok && v != "" {
+					delete(c, IS_PRIMARY_KEY)
+					if pk := upstreamColumns[cname].IsPrimaryKey; pk != nil {
+						c[IS_PRIMARY_KEY] = boolToStr(*pk)
+					}
+				}
+			}
+		}
+	}
+	return schemas
+}
+
+// validateSchemaConfigSettings rejects the primary key columns that are disabled, a primary key column must be
+// synced. A hashed column can be a primary key, the key is computed from the hashed values.
+func validateSchemaConfigSettings(schemas map[string]interface{}) error {
+	for sname, s := range schemas {
+		tables, _ := s.(map[string]interface{})[TABLE].(map[string]interface{})
+		for tname, t := range tables {
+			columns, _ := t.(map[string]interface{})[COLUMN].(map[string]interface{})
+			for cname, c := range columns {
+				cmap := c.(map[string]interface{})
+				if pk, ok := cmap[IS_PRIMARY_KEY].(string); ok && strToBool(pk) {
+					if enabled, ok := cmap[ENABLED].(string); ok && enabled != "" && !strToBool(enabled) {
+						return fmt.Errorf("column %v.%v.%v: a primary key column can't be disabled", sname, tname, cname)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	schemaReloadExcludeModePreserve = "PRESERVE"
+	schemaReloadExcludeModeExclude  = "EXCLUDE"
+)
+
+func resourceConnectorSchemaReload() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceConnectorSchemaReloadCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceConnectorSchemaReloadRead),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceConnectorSchemaReloadDelete),
+		Timeouts:      resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id":           {Type: schema.TypeString, Computed: true},
+			"connector_id": {Type: schema.TypeString, Required: true, ForceNew: true},
+			"triggers":     {Type: schema.TypeMap, Optional: true, ForceNew: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"exclude_mode": {Type: schema.TypeString, Optional: true, ForceNew: true, Default: schemaReloadExcludeModePreserve, ValidateFunc: validation.StringInSlice([]string{schemaReloadExcludeModePreserve, schemaReloadExcludeModeExclude}, false)},
+			"new_tables":   {Type: schema.TypeList, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+		},
+	}
+}
+
+func resourceConnectorSchemaReloadCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient).Client
+	connectorID := d.Get("connector_id").(string)
+
+	// the new tables are the tables of the reloaded schema config missing in the schema config before the reload,
+	// a connector without a schema config yet has no tables before the reload
+	before, err := client.NewConnectorSchemaDetails().ConnectorID(connectorID).Do(ctx)
+	if err != nil && before.Code != "NotFound_SchemaConfig" {
+		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("%v; code: %v; message: %v", err, before.Code, before.Message))
+	}
+
+	// the reload request returns when the reload finishes, it isn't limited by the provider request_timeout but by
+	// the create timeout
+	after, err := client.NewConnectorSchemaReload().ConnectorID(connectorID).ExcludeMode(d.Get("exclude_mode").(string)).Do(withoutRequestTimeout(ctx))
+	if err != nil {
+		// without the reload response the reload can't be confirmed as finished, e.g. after a gateway timeout
+		return newDiagAppend(diags, diag.Error, "schema reload error", fmt.Sprintf("%v; code: %v; message: %v", err, after.Code, after.Message))
+	}
+
+	d.SetId(connectorID)
+	if err := d.Set("new_tables", resourceConnectorSchemaReloadNewTables(&before, &after)); err != nil {
+		return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+	}
+
+	resourceConnectorSchemaReloadRead(ctx, d, m)
+
+	return diags
+}
+
+// resourceConnectorSchemaReloadNewTables returns the sorted schema.table names of the after tables missing in before
+func resourceConnectorSchemaReloadNewTables(before, after *fivetran.ConnectorSchemaDetailsResponse) []string {
+	tables := make([]string, 0)
+	for schemaName, schemaConfig := range after.Data.Schemas {
+		if schemaConfig == nil {
+			continue
+		}
+		var beforeTables map[string]*fivetran.ConnectorSchemaConfigTableResponse
+		if beforeSchema, ok := before.Data.Schemas[schemaName]; ok && beforeSchema != nil {
+			beforeTables = beforeSchema.Tables
+		}
+		for tableName := range schemaConfig.Tables {
+			if _, ok := beforeTables[tableName]; !ok {
+				tables = append(tables, schemaName+"."+tableName)
+			}
+		}
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+func resourceConnectorSchemaReloadRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient).Client
+
+	resp, err := client.NewConnectorDetails().ConnectorID(d.Get("connector_id").(string)).DoCustomMerged(ctx)
+	if err != nil {
+		// If the connector does not exist (404), inform Terraform. We want to immediately
+		// return here to prevent further processing.
+		if resp.Code == "404" {
+			d.SetId("")
+			return nil
+		}
+		return newDiagAppend(diags, diag.Error, "read error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+	}
+
+	return diags
+}
+
+// resourceConnectorSchemaReloadDelete only removes the resource from the state, a reload can't be undone.
+func resourceConnectorSchemaReloadDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,138 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceConnectorSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceConnectorSyncCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceConnectorSyncRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceConnectorSyncUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceConnectorSyncDelete),
+		Timeouts:      resourceTimeouts(),
+		Schema: map[string]*schema.Schema{
+			"id":                  {Type: schema.TypeString, Computed: true},
+			"connector_id":        {Type: schema.TypeString, Required: true, ForceNew: true},
+			"triggers":            {Type: schema.TypeMap, Optional: true, ForceNew: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"wait_for_completion": {Type: schema.TypeBool, Optional: true, Default: true},
+			"poll_interval":       {Type: schema.TypeString, Optional: true, Default: "10s", ValidateFunc: providerDurationValidateFunc},
+			"sync_state":          {Type: schema.TypeString, Computed: true},
+			"succeeded_at":        {Type: schema.TypeString, Computed: true},
+			"failed_at":           {Type: schema.TypeString, Computed: true},
+		},
+	}
+}
+
+func resourceConnectorSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient).Client
+	connectorID := d.Get("connector_id").(string)
+
+	pollInterval, err := time.ParseDuration(d.Get("poll_interval").(string))
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "poll_interval error", fmt.Sprint(err))
+	}
+
+	// the sync is finished when succeeded_at or failed_at moves past the value it has before the sync
+	before, err := client.NewConnectorDetails().ConnectorID(connectorID).DoCustomMerged(ctx)
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("%v; code: %v; message: %v", err, before.Code, before.Message))
+	}
+
+	resp, err := client.NewConnectorSync().ConnectorID(connectorID).Do(ctx)
+	if err != nil {
+		return newDiagAppend(diags, diag.Error, "create error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+	}
+
+	d.SetId(connectorID)
+
+	if d.Get("wait_for_completion").(bool) {
+		diags = resourceConnectorSyncWait(ctx, client, connectorID, &before, pollInterval)
+	}
+
+	resourceConnectorSyncRead(ctx, d, m)
+
+	return diags
+}
+
+// resourceConnectorSyncWait polls the connector details until the sync started after the before details
+// has either succeeded or failed.
+func resourceConnectorSyncWait(ctx context.Context, client *fivetran.Client, connectorID string,
+	before *fivetran.ConnectorCustomMergedDetailsResponse, pollInterval time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for {
+		resp, err := client.NewConnectorDetails().ConnectorID(connectorID).DoCustomMerged(ctx)
+		if err != nil {
+			return newDiagAppend(diags, diag.Error, "wait for sync error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+		}
+
+		if resp.Data.FailedAt.After(before.Data.FailedAt) {
+			return newDiagAppend(diags, diag.Error, "connector sync failed",
+				fmt.Sprintf("failed_at: %v; sync_state: %v%v", resp.Data.FailedAt, resp.Data.Status.SyncState, resourceConnectorStatusDetails(&resp)))
+		}
+		if resp.Data.SucceededAt.After(before.Data.SucceededAt) && resp.Data.Status.SyncState != "syncing" {
+			return diags
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return newDiagAppend(diags, diag.Error, "wait for sync error",
+				fmt.Sprintf("%v; sync_state: %v%v", ctx.Err(), resp.Data.Status.SyncState, resourceConnectorStatusDetails(&resp)))
+		case <-timer.C:
+		}
+	}
+}
+
+func resourceConnectorSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := m.(*ProviderClient).Client
+
+	resp, err := client.NewConnectorDetails().ConnectorID(d.Get("connector_id").(string)).DoCustomMerged(ctx)
+	if err != nil {
+		// If the connector does not exist (404), inform Terraform. We want to immediately
+		// return here to prevent further processing.
+		if resp.Code == "404" {
+			d.SetId("")
+			return nil
+		}
+		return newDiagAppend(diags, diag.Error, "read error", fmt.Sprintf("%v; code: %v; message: %v", err, resp.Code, resp.Message))
+	}
+
+	// msi stands for Map String Interface
+	msi := make(map[string]interface{})
+	msi["sync_state"] = resp.Data.Status.SyncState
+	msi["succeeded_at"] = resp.Data.SucceededAt.String()
+	msi["failed_at"] = resp.Data.FailedAt.String()
+	for k, v := range msi {
+		if err := d.Set(k, v); err != nil {
+			return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+		}
+	}
+
+	return diags
+}
+
+// resourceConnectorSyncUpdate only saves the wait_for_completion and poll_interval values,
+// all the other fields force a new sync.
+func resourceConnectorSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceConnectorSyncRead(ctx, d, m)
+}
+
+// resourceConnectorSyncDelete only removes the resource from the state, a finished sync can't be undone.
+func resourceConnectorSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,468 @@
+package fivetran
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceConnectorV0BoolFields and resourceConnectorV0IntFields were TypeString in the resource schema version 0
+var resourceConnectorV0BoolFields = []string{"trust_certificates", "trust_fingerprints", "run_setup_tests", "paused", "pause_after_trial"}
+var resourceConnectorV0IntFields = []string{"sync_frequency"}
+
+// resourceConnectorV0ConfigBoolFields and resourceConnectorV0ConfigIntFields were TypeString in the config block
+// of the resource schema version 0
+var resourceConnectorV0ConfigBoolFields = []string{
+	"always_encrypted", "asm_option", "empty_header", "enable_all_dimension_combinations", "eu_region",
+	"is_account_level_connector", "is_ftps", "is_keypair", "is_multi_entity_feature_enabled", "is_new_package",
+	"is_public", "is_secure", "is_single_table_mode", "on_premise", "sftp_is_key_pair", "sync_data_locker",
+	"update_config_on_each_sync", "use_api_keys", "use_oracle_rac", "use_webhooks",
+}
+var resourceConnectorV0ConfigIntFields = []string{
+	"agent_port", "api_quota", "conversion_window_size", "daily_api_call_limit", "ftp_port", "port", "sftp_port",
+	"skip_after", "skip_before", "tunnel_port",
+}
+
+// resourceConnectorStateUpgradeV0 converts the string values of the boolean and integer fields of the resource
+// schema version 0, the empty strings are the unset values. The values that can't be converted are set to null,
+// the next read sets them from the connector.
+func resourceConnectorStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	for _, k := range resourceConnectorV0BoolFields {
+		stateUpgradeStrToBool(ctx, rawState, k, k)
+	}
+	for _, k := range resourceConnectorV0IntFields {
+		stateUpgradeStrToInt(ctx, rawState, k, k)
+	}
+
+	config, ok := rawState["config"].([]interface{})
+	if !ok || len(config) == 0 || config[0] == nil {
+		return rawState, nil
+	}
+	c := config[0].(map[string]interface{})
+	for _, k := range resourceConnectorV0ConfigBoolFields {
+		stateUpgradeStrToBool(ctx, c, k, "config.0."+k)
+	}
+	for _, k := range resourceConnectorV0ConfigIntFields {
+		stateUpgradeStrToInt(ctx, c, k, "config.0."+k)
+	}
+
+	return rawState, nil
+}
+
+// resourceConnectorV0 returns the resource schema version 0, the boolean and integer fields were strings. It only
+// decodes the state written before the schema version 1, so it is a frozen copy that must not follow the changes of
+// the current schema.
+func resourceConnectorV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id":                 {Type: schema.TypeString, Computed: true},
+			"group_id":           {Type: schema.TypeString, Required: true, ForceNew: true},
+			"service":            {Type: schema.TypeString, Required: true, ForceNew: true},
+			"service_version":    {Type: schema.TypeString, Computed: true},
+			"destination_schema": resourceConnectorV0DestinationSchema(),
+			"name":               {Type: schema.TypeString, Computed: true},
+			"connected_by":       {Type: schema.TypeString, Computed: true},
+			"created_at":         {Type: schema.TypeString, Computed: true},
+			"succeeded_at":       {Type: schema.TypeString, Computed: true},
+			"failed_at":          {Type: schema.TypeString, Computed: true},
+			"sync_frequency":     {Type: schema.TypeString, Required: true},
+			"daily_sync_time":    {Type: schema.TypeString, Optional: true},
+			"schedule_type":      {Type: schema.TypeString, Computed: true},
+			"trust_certificates": {Type: schema.TypeString, Optional: true},
+			"trust_fingerprints": {Type: schema.TypeString, Optional: true},
+			"run_setup_tests":    {Type: schema.TypeString, Optional: true},
+			"paused":             {Type: schema.TypeString, Required: true},
+			"pause_after_trial":  {Type: schema.TypeString, Required: true},
+			"status":             resourceConnectorV0Status(),
+			"config":             resourceConnectorV0Config(),
+			"auth":               resourceConnectorV0Auth(),
+			"last_updated":       {Type: schema.TypeString, Computed: true}, // internal
+		},
+	}
+}
+
+func resourceConnectorV0DestinationSchema() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name":   {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"table":  {Type: schema.TypeString, Optional: true, ForceNew: true},
+				"prefix": {Type: schema.TypeString, Optional: true, ForceNew: true},
+			},
+		},
+	}
+}
+
+func resourceConnectorV0Status() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"setup_state":        {Type: schema.TypeString, Computed: true},
+				"sync_state":         {Type: schema.TypeString, Computed: true},
+				"update_state":       {Type: schema.TypeString, Computed: true},
+				"is_historical_sync": {Type: schema.TypeString, Computed: true},
+				"tasks": {Type: schema.TypeList, Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"code":    {Type: schema.TypeString, Computed: true},
+							"message": {Type: schema.TypeString, Computed: true},
+						},
+					},
+				},
+				"warnings": {Type: schema.TypeList, Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"code":    {Type: schema.TypeString, Computed: true},
+							"message": {Type: schema.TypeString, Computed: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConnectorV0Config() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Optional: true, Computed: true, MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				// Readonly config fields
+				"latest_version":            {Type: schema.TypeString, Computed: true},
+				"authorization_method":      {Type: schema.TypeString, Computed: true},
+				"service_version":           {Type: schema.TypeString, Computed: true},
+				"last_synced_changes__utc_": {Type: schema.TypeString, Computed: true},
+
+				// Sensitive config fields, Fivetran returns this fields masked
+				"oauth_token":        {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"oauth_token_secret": {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"consumer_key":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"client_secret":      {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"private_key":        {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"s3role_arn":         {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"ftp_password":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"sftp_password":      {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"api_key":            {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"role_arn":           {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"password":           {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"secret_key":         {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"pem_certificate":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"access_token":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"api_secret":         {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"api_access_token":   {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"secret":             {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"consumer_secret":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"secrets":            {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"api_token":          {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"encryption_key":     {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"pat":                {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"function_trigger":   {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"token_key":          {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"token_secret":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"agent_password":     {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"asm_password":       {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"login_password":     {Type: schema.TypeString, Optional: true, Sensitive: true},
+
+				// Fields that always have default value (and should be marked as Computed to prevent drifting)
+				// Boolean values
+				"is_ftps":                           {Type: schema.TypeString, Optional: true, Computed: true},
+				"sftp_is_key_pair":                  {Type: schema.TypeString, Optional: true, Computed: true},
+				"sync_data_locker":                  {Type: schema.TypeString, Optional: true, Computed: true},
+				"enable_all_dimension_combinations": {Type: schema.TypeString, Optional: true, Computed: true},
+				"update_config_on_each_sync":        {Type: schema.TypeString, Optional: true, Computed: true},
+				"on_premise":                        {Type: schema.TypeString, Optional: true, Computed: true},
+				"use_api_keys":                      {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_new_package":                    {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_multi_entity_feature_enabled":   {Type: schema.TypeString, Optional: true, Computed: true},
+				"always_encrypted":                  {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_secure":                         {Type: schema.TypeString, Optional: true, Computed: true},
+				"use_webhooks":                      {Type: schema.TypeString, Optional: true, Computed: true},
+				"eu_region":                         {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_keypair":                        {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_account_level_connector":        {Type: schema.TypeString, Optional: true, Computed: true},
+				"use_oracle_rac":                    {Type: schema.TypeString, Optional: true, Computed: true},
+				"asm_option":                        {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_single_table_mode":              {Type: schema.TypeString, Optional: true, Computed: true},
+				"is_public":                         {Type: schema.TypeString, Optional: true, Computed: true},
+				"empty_header":                      {Type: schema.TypeString, Optional: true, Computed: true},
+
+				// Enum & int values
+				"connection_type":                      {Type: schema.TypeString, Optional: true, Computed: true},
+				"sync_method":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"sync_mode":                            {Type: schema.TypeString, Optional: true, Computed: true},
+				"date_granularity":                     {Type: schema.TypeString, Optional: true, Computed: true},
+				"timeframe_months":                     {Type: schema.TypeString, Optional: true, Computed: true},
+				"report_type":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"aggregation":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"config_type":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"prebuilt_report":                      {Type: schema.TypeString, Optional: true, Computed: true},
+				"action_report_time":                   {Type: schema.TypeString, Optional: true, Computed: true},
+				"click_attribution_window":             {Type: schema.TypeString, Optional: true, Computed: true},
+				"view_attribution_window":              {Type: schema.TypeString, Optional: true, Computed: true},
+				"conversion_window_size":               {Type: schema.TypeString, Optional: true, Computed: true},
+				"view_through_attribution_window_size": {Type: schema.TypeString, Optional: true, Computed: true},
+				"post_click_attribution_window_size":   {Type: schema.TypeString, Optional: true, Computed: true},
+				"update_method":                        {Type: schema.TypeString, Optional: true, Computed: true},
+				"swipe_attribution_window":             {Type: schema.TypeString, Optional: true, Computed: true},
+				"api_type":                             {Type: schema.TypeString, Optional: true, Computed: true},
+				"auth_type":                            {Type: schema.TypeString, Optional: true, Computed: true},
+				"sync_format":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"app_sync_mode":                        {Type: schema.TypeString, Optional: true, Computed: true},
+				"sales_account_sync_mode":              {Type: schema.TypeString, Optional: true, Computed: true},
+				"finance_account_sync_mode":            {Type: schema.TypeString, Optional: true, Computed: true},
+				"source":                               {Type: schema.TypeString, Optional: true, Computed: true},
+				"file_type":                            {Type: schema.TypeString, Optional: true, Computed: true},
+				"compression":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"on_error":                             {Type: schema.TypeString, Optional: true, Computed: true},
+				"append_file_option":                   {Type: schema.TypeString, Optional: true, Computed: true},
+				"engagement_attribution_window":        {Type: schema.TypeString, Optional: true, Computed: true},
+				"conversion_report_time":               {Type: schema.TypeString, Optional: true, Computed: true},
+				"skip_before":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"skip_after":                           {Type: schema.TypeString, Optional: true, Computed: true},
+				"ftp_port":                             {Type: schema.TypeString, Optional: true, Computed: true},
+				"sftp_port":                            {Type: schema.TypeString, Optional: true, Computed: true},
+				"port":                                 {Type: schema.TypeString, Optional: true, Computed: true},
+				"tunnel_port":                          {Type: schema.TypeString, Optional: true, Computed: true},
+				"daily_api_call_limit":                 {Type: schema.TypeString, Optional: true, Computed: true},
+				"api_quota":                            {Type: schema.TypeString, Optional: true, Computed: true},
+				"agent_port":                           {Type: schema.TypeString, Optional: true, Computed: true},
+
+				// For db-like connectors it's a readonly field, but it also used in Braintree connector as public field
+				"public_key": {Type: schema.TypeString, Optional: true, Computed: true},
+
+				// external_id is used among AWS connectors and correcponds to group_id. For some connectors it is computed, some expect it as a parameter.
+				"external_id": {Type: schema.TypeString, Optional: true, Computed: true},
+
+				"asm_oracle_home":       {Type: schema.TypeString, Optional: true},
+				"asm_tns":               {Type: schema.TypeString, Optional: true},
+				"pdb_name":              {Type: schema.TypeString, Optional: true},
+				"agent_host":            {Type: schema.TypeString, Optional: true},
+				"agent_user":            {Type: schema.TypeString, Optional: true},
+				"agent_public_cert":     {Type: schema.TypeString, Optional: true},
+				"agent_ora_home":        {Type: schema.TypeString, Optional: true},
+				"tns":                   {Type: schema.TypeString, Optional: true},
+				"asm_user":              {Type: schema.TypeString, Optional: true},
+				"sap_user":              {Type: schema.TypeString, Optional: true},
+				"sheet_id":              {Type: schema.TypeString, Optional: true},
+				"named_range":           {Type: schema.TypeString, Optional: true},
+				"client_id":             {Type: schema.TypeString, Optional: true},
+				"technical_account_id":  {Type: schema.TypeString, Optional: true},
+				"organization_id":       {Type: schema.TypeString, Optional: true},
+				"s3bucket":              {Type: schema.TypeString, Optional: true},
+				"abs_connection_string": {Type: schema.TypeString, Optional: true},
+				"abs_container_name":    {Type: schema.TypeString, Optional: true},
+				"folder_id":             {Type: schema.TypeString, Optional: true},
+				"ftp_host":              {Type: schema.TypeString, Optional: true},
+				"ftp_user":              {Type: schema.TypeString, Optional: true},
+				"sftp_host":             {Type: schema.TypeString, Optional: true},
+				"sftp_user":             {Type: schema.TypeString, Optional: true},
+				"bucket":                {Type: schema.TypeString, Optional: true},
+				"prefix":                {Type: schema.TypeString, Optional: true},
+				"pattern":               {Type: schema.TypeString, Optional: true},
+				"archive_pattern":       {Type: schema.TypeString, Optional: true},
+				"null_sequence":         {Type: schema.TypeString, Optional: true},
+				"delimiter":             {Type: schema.TypeString, Optional: true},
+				"escape_char":           {Type: schema.TypeString, Optional: true},
+				"auth_mode":             {Type: schema.TypeString, Optional: true},
+				"certificate":           {Type: schema.TypeString, Optional: true},
+				"consumer_group":        {Type: schema.TypeString, Optional: true},
+				"servers":               {Type: schema.TypeString, Optional: true},
+				"message_type":          {Type: schema.TypeString, Optional: true},
+				"sync_type":             {Type: schema.TypeString, Optional: true},
+				"security_protocol":     {Type: schema.TypeString, Optional: true},
+				"access_key_id":         {Type: schema.TypeString, Optional: true},
+				"home_folder":           {Type: schema.TypeString, Optional: true},
+				"function":              {Type: schema.TypeString, Optional: true},
+				"region":                {Type: schema.TypeString, Optional: true},
+				"container_name":        {Type: schema.TypeString, Optional: true},
+				"connection_string":     {Type: schema.TypeString, Optional: true},
+				"function_app":          {Type: schema.TypeString, Optional: true},
+				"function_name":         {Type: schema.TypeString, Optional: true},
+				"function_key":          {Type: schema.TypeString, Optional: true},
+				"merchant_id":           {Type: schema.TypeString, Optional: true},
+				"api_url":               {Type: schema.TypeString, Optional: true},
+				"cloud_storage_type":    {Type: schema.TypeString, Optional: true},
+				"s3external_id":         {Type: schema.TypeString, Optional: true},
+				"s3folder":              {Type: schema.TypeString, Optional: true},
+				"gcs_bucket":            {Type: schema.TypeString, Optional: true},
+				"gcs_folder":            {Type: schema.TypeString, Optional: true},
+				"instance":              {Type: schema.TypeString, Optional: true},
+				"aws_region_code":       {Type: schema.TypeString, Optional: true},
+				"subdomain":             {Type: schema.TypeString, Optional: true},
+				"host":                  {Type: schema.TypeString, Optional: true},
+				"user":                  {Type: schema.TypeString, Optional: true},
+				"network_code":          {Type: schema.TypeString, Optional: true},
+				"customer_id":           {Type: schema.TypeString, Optional: true},
+				"project_id":            {Type: schema.TypeString, Optional: true},
+				"dataset_id":            {Type: schema.TypeString, Optional: true},
+				"bucket_name":           {Type: schema.TypeString, Optional: true},
+				"config_method":         {Type: schema.TypeString, Optional: true},
+				"query_id":              {Type: schema.TypeString, Optional: true},
+				"path":                  {Type: schema.TypeString, Optional: true},
+				"endpoint":              {Type: schema.TypeString, Optional: true},
+				"identity":              {Type: schema.TypeString, Optional: true},
+				"domain_name":           {Type: schema.TypeString, Optional: true},
+				"resource_url":          {Type: schema.TypeString, Optional: true},
+				"tunnel_host":           {Type: schema.TypeString, Optional: true},
+				"tunnel_user":           {Type: schema.TypeString, Optional: true},
+				"database":              {Type: schema.TypeString, Optional: true},
+				"datasource":            {Type: schema.TypeString, Optional: true},
+				"account":               {Type: schema.TypeString, Optional: true},
+				"role":                  {Type: schema.TypeString, Optional: true},
+				"email":                 {Type: schema.TypeString, Optional: true},
+				"account_id":            {Type: schema.TypeString, Optional: true},
+				"server_url":            {Type: schema.TypeString, Optional: true},
+				"user_key":              {Type: schema.TypeString, Optional: true},
+				"api_version":           {Type: schema.TypeString, Optional: true},
+				"time_zone":             {Type: schema.TypeString, Optional: true},
+				"integration_key":       {Type: schema.TypeString, Optional: true},
+				"domain":                {Type: schema.TypeString, Optional: true},
+				"replication_slot":      {Type: schema.TypeString, Optional: true},
+				"publication_name":      {Type: schema.TypeString, Optional: true},
+				"data_center":           {Type: schema.TypeString, Optional: true},
+				"sub_domain":            {Type: schema.TypeString, Optional: true},
+				"test_table_name":       {Type: schema.TypeString, Optional: true},
+				"shop":                  {Type: schema.TypeString, Optional: true},
+				"sid":                   {Type: schema.TypeString, Optional: true},
+				"key":                   {Type: schema.TypeString, Optional: true},
+				"bucket_service":        {Type: schema.TypeString, Optional: true},
+				"user_name":             {Type: schema.TypeString, Optional: true},
+				"username":              {Type: schema.TypeString, Optional: true},
+				"report_url":            {Type: schema.TypeString, Optional: true},
+				"unique_id":             {Type: schema.TypeString, Optional: true},
+				"base_url":              {Type: schema.TypeString, Optional: true},
+				"entity_id":             {Type: schema.TypeString, Optional: true},
+				"soap_uri":              {Type: schema.TypeString, Optional: true},
+				"user_id":               {Type: schema.TypeString, Optional: true},
+				"share_url":             {Type: schema.TypeString, Optional: true},
+				"organization":          {Type: schema.TypeString, Optional: true},
+				"access_key":            {Type: schema.TypeString, Optional: true},
+				"domain_host_name":      {Type: schema.TypeString, Optional: true},
+				"client_name":           {Type: schema.TypeString, Optional: true},
+				"domain_type":           {Type: schema.TypeString, Optional: true},
+				"connection_method":     {Type: schema.TypeString, Optional: true},
+				"group_name":            {Type: schema.TypeString, Optional: true},
+				"company_id":            {Type: schema.TypeString, Optional: true},
+				"environment":           {Type: schema.TypeString, Optional: true},
+				"list_strategy":         {Type: schema.TypeString, Optional: true},
+
+				// Collections
+				"report_suites":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"elements":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"metrics":                  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"advertisables":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"dimensions":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"selected_exports":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"apps":                     {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"sales_accounts":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"finance_accounts":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"projects":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"user_profiles":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"report_configuration_ids": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"accounts":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"fields":                   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"breakdowns":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"action_breakdowns":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"pages":                    {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"repositories":             {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"dimension_attributes":     {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"columns":                  {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"manager_accounts":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"profiles":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"site_urls":                {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"api_keys":                 {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"advertisers_id":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"hosts":                    {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"advertisers":              {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"organizations":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"account_ids":              {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"packed_mode_tables":       {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				"properties":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+
+				"secrets_list": {Type: schema.TypeSet, Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key":   {Type: schema.TypeString, Required: true},
+							"value": {Type: schema.TypeString, Required: true, Sensitive: true},
+						},
+					},
+				},
+
+				"adobe_analytics_configurations": {Type: schema.TypeSet, Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"sync_mode":          {Type: schema.TypeString, Optional: true},
+							"report_suites":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"elements":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"metrics":            {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"calculated_metrics": {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"segments":           {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+						},
+					},
+				},
+				"reports": {Type: schema.TypeSet, Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"table":           {Type: schema.TypeString, Optional: true},
+							"config_type":     {Type: schema.TypeString, Optional: true, Computed: true},
+							"prebuilt_report": {Type: schema.TypeString, Optional: true},
+							"report_type":     {Type: schema.TypeString, Optional: true, Computed: true},
+							"fields":          {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"dimensions":      {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"metrics":         {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"segments":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"filter":          {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+				"custom_tables": {Type: schema.TypeSet, Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"table_name":               {Type: schema.TypeString, Optional: true},
+							"config_type":              {Type: schema.TypeString, Optional: true, Computed: true},
+							"fields":                   {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"breakdowns":               {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"action_breakdowns":        {Type: schema.TypeSet, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+							"aggregation":              {Type: schema.TypeString, Optional: true, Computed: true},
+							"action_report_time":       {Type: schema.TypeString, Optional: true, Computed: true},
+							"click_attribution_window": {Type: schema.TypeString, Optional: true, Computed: true},
+							"view_attribution_window":  {Type: schema.TypeString, Optional: true, Computed: true},
+							"prebuilt_report_name":     {Type: schema.TypeString, Optional: true},
+						},
+					},
+				},
+				"project_credentials": {Type: schema.TypeSet, Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"project":    {Type: schema.TypeString, Optional: true},
+							"api_key":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+							"secret_key": {Type: schema.TypeString, Optional: true, Sensitive: true},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceConnectorV0Auth() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeList, Optional: true, MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"client_access": {Type: schema.TypeList, Optional: true, MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"client_id":       {Type: schema.TypeString, Optional: true},
+							"client_secret":   {Type: schema.TypeString, Optional: true, Sensitive: true},
+							"user_agent":      {Type: schema.TypeString, Optional: true},
+							"developer_token": {Type: schema.TypeString, Optional: true, Sensitive: true},
+						},
+					},
+				},
+				"refresh_token": {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"access_token":  {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"realm_id":      {Type: schema.TypeString, Optional: true, Sensitive: true},
+			},
+		},
+	}
+}
@@ -7,17 +7,19 @@ import (
 	"time"
 
 	"github.com/fivetran/go-fivetran"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceDestination() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceDestinationCreate,
-		ReadContext:   resourceDestinationRead,
-		UpdateContext: resourceDestinationUpdate,
-		DeleteContext: resourceDestinationDelete,
-		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceDestinationCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceDestinationRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceDestinationUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceDestinationDelete),
+		Timeouts:      resourceTimeouts(),
+		Importer:      &schema.ResourceImporter{StateContext: resourceDestinationImport},
 		Schema: map[string]*schema.Schema{
 			"id":                 {Type: schema.TypeString, Computed: true},
 			"group_id":           {Type: schema.TypeString, Required: true, ForceNew: true},
@@ -28,12 +30,17 @@ func resourceDestination() *schema.Resource {
 			"trust_certificates": {Type: schema.TypeBool, Optional: true},
 			"trust_fingerprints": {Type: schema.TypeBool, Optional: true},
 			"run_setup_tests":    {Type: schema.TypeBool, Optional: true, Default: false},
+			"secrets_version":    {Type: schema.TypeInt, Optional: true},
 			"setup_status":       {Type: schema.TypeString, Computed: true},
 			"last_updated":       {Type: schema.TypeString, Computed: true}, // internal
 		},
 	}
 }
 
+// resourceDestinationConfigSecretFields are the config fields masked in the REST API responses, the state keeps their
+// salted hashes
+var resourceDestinationConfigSecretFields = []string{"password", "personal_access_token", "role_arn", "secret_key", "private_key", "passphrase"}
+
 func resourceDestinationSchemaConfig() *schema.Schema {
 	return &schema.Schema{Type: schema.TypeList, Required: true, MaxItems: 1,
 		Elem: &schema.Resource{
@@ -43,7 +50,7 @@ func resourceDestinationSchemaConfig() *schema.Schema {
 				"database":                 {Type: schema.TypeString, Optional: true},
 				"auth":                     {Type: schema.TypeString, Optional: true},
 				"user":                     {Type: schema.TypeString, Optional: true},
-				"password":                 {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"password":                 {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
 				"connection_type":          {Type: schema.TypeString, Optional: true},
 				"tunnel_host":              {Type: schema.TypeString, Optional: true},
 				"tunnel_port":              {Type: schema.TypeString, Optional: true},
@@ -53,19 +60,19 @@ func resourceDestinationSchemaConfig() *schema.Schema {
 				"bucket":                   {Type: schema.TypeString, Optional: true},
 				"server_host_name":         {Type: schema.TypeString, Optional: true},
 				"http_path":                {Type: schema.TypeString, Optional: true},
-				"personal_access_token":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"personal_access_token":    {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
 				"create_external_tables":   {Type: schema.TypeString, Optional: true},
 				"external_location":        {Type: schema.TypeString, Optional: true},
 				"auth_type":                {Type: schema.TypeString, Optional: true},
-				"role_arn":                 {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"secret_key":               {Type: schema.TypeString, Optional: true, Sensitive: true},
-				"private_key":              {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"role_arn":                 {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
+				"secret_key":               {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
+				"private_key":              {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
 				"public_key":               {Type: schema.TypeString, Computed: true},
 				"cluster_id":               {Type: schema.TypeString, Optional: true},
 				"cluster_region":           {Type: schema.TypeString, Optional: true},
 				"role":                     {Type: schema.TypeString, Optional: true},
 				"is_private_key_encrypted": {Type: schema.TypeString, Optional: true, Computed: true},
-				"passphrase":               {Type: schema.TypeString, Optional: true, Sensitive: true},
+				"passphrase":               {Type: schema.TypeString, Optional: true, Sensitive: true, DiffSuppressFunc: secretDiffSuppressFunc},
 				"catalog":                  {Type: schema.TypeString, Optional: true},
 			},
 		},
@@ -74,7 +81,7 @@ func resourceDestinationSchemaConfig() *schema.Schema {
 
 func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewDestinationCreate()
 
 	svc.GroupID(d.Get("group_id").(string))
@@ -107,7 +114,7 @@ func resourceDestinationCreate(ctx context.Context, d *schema.ResourceData, m in
 
 func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewDestinationDetails()
 
 	resp, err := svc.DestinationID(d.Get("id").(string)).Do(ctx)
@@ -145,7 +152,7 @@ func resourceDestinationRead(ctx context.Context, d *schema.ResourceData, m inte
 
 func resourceDestinationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewDestinationModify()
 
 	svc.DestinationID(d.Get("id").(string))
@@ -164,7 +171,15 @@ func resourceDestinationUpdate(ctx context.Context, d *schema.ResourceData, m in
 		_, n := d.GetChange("config")
 		// resourceDestinationCreateConfig is used here because
 		// the whole "config" block must be sent to the REST API.
-		if v, ok := resourceDestinationCreateConfig(n.([]interface{})); ok {
+		config := resourceDestinationUnmaskConfig(n.([]interface{}), connectorConfigRawBlock(d.GetRawConfig().GetAttr("config")))
+		if v, ok := resourceDestinationCreateConfig(config); ok {
+			stateConfig, err := resourceDestinationHashConfig(config)
+			if err != nil {
+				return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+			}
+			if err := d.Set("config", stateConfig); err != nil {
+				return newDiagAppend(diags, diag.Error, "set error", fmt.Sprint(err))
+			}
 			svc.Config(v)
 			hasChanges = true
 			// only sets change if func resourceDestinationCreateConfig returns ok
@@ -207,7 +222,7 @@ func resourceDestinationUpdate(ctx context.Context, d *schema.ResourceData, m in
 
 func resourceDestinationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewDestinationDelete()
 
 	resp, err := svc.DestinationID(d.Get("id").(string)).Do(ctx)
@@ -239,19 +254,31 @@ func resourceDestinationReadConfig(resp *fivetran.DestinationDetailsResponse, cu
 	c["user"] = resp.Data.Config.User
 
 	if len(currentConfig) > 0 {
-		// The REST API sends the password field masked. We use the state stored password here if possible.
+		// The REST API sends the secrets masked. We keep the salted hashes of the state stored secrets here.
 		currentConfigMap := currentConfig[0].(map[string]interface{})
-		c["password"] = currentConfigMap["password"].(string)
-		c["private_key"] = currentConfigMap["private_key"].(string)
-		c["secret_key"] = currentConfigMap["secret_key"].(string)
-		c["personal_access_token"] = currentConfigMap["personal_access_token"].(string)
-		c["role_arn"] = currentConfigMap["role_arn"].(string)
-		c["passphrase"] = currentConfigMap["passphrase"].(string)
+		for _, k := range resourceDestinationConfigSecretFields {
+			v, err := secretStateValue(currentConfigMap[k].(string))
+			if err != nil {
+				return config, err
+			}
+			c[k] = v
+		}
 
 		if _, ok := currentConfigMap["is_private_key_encrypted"]; ok {
 			// if `is_private_key_encrypted` is configured locally we should read upstream value
 			c["is_private_key_encrypted"] = resp.Data.Config.IsPrivateKeyEncrypted
 		}
+	} else {
+		// There is no state config after the import. The secrets set upstream are masked, their values are unknown:
+		// the state keeps the masked values and secretDiffSuppressFunc doesn't report a diff for them.
+		for k, v := range resourceDestinationReadConfigSecrets(resp) {
+			sv, err := secretStateValue(v)
+			if err != nil {
+				return config, err
+			}
+			c[k] = sv
+		}
+		c["is_private_key_encrypted"] = resp.Data.Config.IsPrivateKeyEncrypted
 	}
 
 	if strToBool(resp.Data.Config.IsPrivateKeyEncrypted) {
@@ -290,6 +317,57 @@ func resourceDestinationReadConfig(resp *fivetran.DestinationDetailsResponse, cu
 	return config, nil
 }
 
+// resourceDestinationReadConfigSecrets returns the upstream values of resourceDestinationConfigSecretFields
+func resourceDestinationReadConfigSecrets(resp *fivetran.DestinationDetailsResponse) map[string]string {
+	return map[string]string{
+		"password":              resp.Data.Config.Password,
+		"personal_access_token": resp.Data.Config.PersonalAccessToken,
+		"role_arn":              resp.Data.Config.RoleArn,
+		"secret_key":            resp.Data.Config.SecretKey,
+		"private_key":           resp.Data.Config.PrivateKey,
+		"passphrase":            resp.Data.Config.Passphrase,
+	}
+}
+
+// resourceDestinationImport imports a destination by its ID, run_setup_tests isn't returned by the REST API and is set
+// to its default value, so the plan of an imported destination matches the plan of a created one
+func resourceDestinationImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("run_setup_tests", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceDestinationUnmaskConfig replaces the salted hashes of the unchanged secrets with the configured values, the
+// whole config block is sent to the REST API and the hashes are never sent
+func resourceDestinationUnmaskConfig(config []interface{}, raw cty.Value) []interface{} {
+	c := copyMap(config[0].(map[string]interface{}))
+	for _, k := range resourceDestinationConfigSecretFields {
+		if secretIsPlain(c[k].(string)) {
+			continue
+		}
+		c[k] = ""
+		if connectorConfigRawIsSet(raw, k) && raw.GetAttr(k).IsKnown() {
+			c[k] = raw.GetAttr(k).AsString()
+		}
+	}
+	return []interface{}{c}
+}
+
+// resourceDestinationHashConfig returns a copy of the config block with the salted hashes of its secrets, the plain
+// values sent to the REST API never reach the state
+func resourceDestinationHashConfig(config []interface{}) ([]interface{}, error) {
+	c := copyMap(config[0].(map[string]interface{}))
+	for _, k := range resourceDestinationConfigSecretFields {
+		v, err := secretStateValue(c[k].(string))
+		if err != nil {
+			return nil, err
+		}
+		c[k] = v
+	}
+	return []interface{}{c}, nil
+}
+
 func resourceDestinationIsBigQuery(service string) bool {
 	return service == "big_query" || service == "managed_big_query" || service == "big_query_dts"
 }
@@ -5,17 +5,17 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/fivetran/go-fivetran"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceGroupCreate,
-		ReadContext:   resourceGroupRead,
-		UpdateContext: resourceGroupUpdate,
-		DeleteContext: resourceGroupDelete,
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceGroupCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceGroupRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceGroupUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceGroupDelete),
+		Timeouts:      resourceTimeouts(),
 		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
 		Schema: map[string]*schema.Schema{
 			"id":           {Type: schema.TypeString, Computed: true},
@@ -28,7 +28,7 @@ func resourceGroup() *schema.Resource {
 
 func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewGroupCreate()
 
 	resp, err := svc.Name(d.Get("name").(string)).Do(ctx)
@@ -44,7 +44,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, m interfac
 
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewGroupDetails()
 
 	groupID := d.Get("id").(string)
@@ -77,7 +77,7 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, m interface{
 
 func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewGroupModify()
 	var change bool
 
@@ -107,7 +107,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, m interfac
 
 func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewGroupDelete()
 
 	resp, err := svc.GroupID(d.Get("id").(string)).Do(ctx)
@@ -20,11 +20,12 @@ type userType struct {
 
 func resourceGroupUsers() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceGroupUsersCreate,
-		ReadContext:   resourceGroupUsersRead,
-		UpdateContext: resourceGroupUsersUpdate,
-		DeleteContext: resourceGroupUsersDelete,
-		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceGroupUsersCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceGroupUsersRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceGroupUsersUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceGroupUsersDelete),
+		Timeouts:      resourceTimeouts(),
+		Importer:      &schema.ResourceImporter{StateContext: resourceGroupUsersImport},
 		Schema: map[string]*schema.Schema{
 			"id":           {Type: schema.TypeString, Computed: true},
 			"group_id":     {Type: schema.TypeString, Required: true},
@@ -48,7 +49,7 @@ func resourceGroupUsersSchemaUser() *schema.Schema {
 
 func resourceGroupUsersCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	var groupID = d.Get("group_id").(string)
 
@@ -73,7 +74,7 @@ func resourceGroupUsersCreate(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceGroupUsersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	groupID := d.Get("id").(string)
 
 	respUsers, err := dataSourceGroupUsersGetUsers(client, groupID, ctx)
@@ -94,9 +95,26 @@ func resourceGroupUsersRead(ctx context.Context, d *schema.ResourceData, m inter
 	return diags
 }
 
+// resourceGroupUsersImport imports the users of a group by the group ID
+func resourceGroupUsersImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*ProviderClient).Client
+
+	resp, err := client.NewGroupDetails().GroupID(d.Id()).Do(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%v; code: %v; message: %v", err, resp.Code, resp.Message)
+	}
+
+	d.SetId(resp.Data.ID)
+	if err := d.Set("group_id", resp.Data.ID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceGroupUsersUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	groupID := d.Get("group_id").(string)
 
 	if d.HasChange("user") {
@@ -114,7 +132,7 @@ func resourceGroupUsersUpdate(ctx context.Context, d *schema.ResourceData, m int
 
 func resourceGroupUsersDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	groupID := d.Get("group_id").(string)
 	users := d.Get("user").(*schema.Set).List()
 
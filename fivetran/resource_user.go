@@ -5,17 +5,17 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/fivetran/go-fivetran"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
-		CreateContext: resourceUserCreate,
-		ReadContext:   resourceUserRead,
-		UpdateContext: resourceUserUpdate,
-		DeleteContext: resourceUserDelete,
+		CreateContext: withTimeout(schema.TimeoutCreate, resourceUserCreate),
+		ReadContext:   withTimeout(schema.TimeoutRead, resourceUserRead),
+		UpdateContext: withTimeout(schema.TimeoutUpdate, resourceUserUpdate),
+		DeleteContext: withTimeout(schema.TimeoutDelete, resourceUserDelete),
+		Timeouts:      resourceTimeouts(),
 		Importer:      &schema.ResourceImporter{StateContext: schema.ImportStatePassthroughContext},
 		Schema: map[string]*schema.Schema{
 			"id": {Type: schema.TypeString, Computed: true},
@@ -40,7 +40,7 @@ func resourceUser() *schema.Resource {
 
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewUserInvite()
 
 	svc.Email(d.Get("email").(string))
@@ -72,7 +72,7 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewUserDetails()
 
 	svc.UserID(d.Get("id").(string)).Do(ctx)
@@ -112,7 +112,7 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, m interface{}
 
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 
 	svc := client.NewUserModify()
 
@@ -158,7 +158,7 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	client := m.(*fivetran.Client)
+	client := m.(*ProviderClient).Client
 	svc := client.NewUserDelete()
 
 	resp, err := svc.UserID(d.Get("id").(string)).Do(ctx)
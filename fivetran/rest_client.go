@@ -0,0 +1,101 @@
+package fivetran
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/fivetran/go-fivetran"
+)
+
+// restClient performs the REST API requests the SDK doesn't implement. It sends them with the HttpClient of the
+// SDK client, so they share its retries, rate limiting and logging.
+type restClient struct {
+	baseURL       string
+	authorization string
+	httpClient    fivetran.HttpClient
+}
+
+// restResponse is the common part of the REST API responses, data is left to the caller to decode
+type restResponse struct {
+	Code    string          `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// ProviderClient is the provider meta: the SDK client and the restClient for the REST API requests the SDK doesn't
+// implement. Both perform the requests with the same HttpClient.
+type ProviderClient struct {
+	*fivetran.Client
+	rest *restClient
+}
+
+// NewClient returns the ProviderClient for the REST API at baseURL that performs the requests with httpClient.
+func NewClient(apiKey, apiSecret, baseURL string, httpClient fivetran.HttpClient) *ProviderClient {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	client := fivetran.New(apiKey, apiSecret)
+	client.BaseURL(baseURL)
+	client.SetHttpClient(httpClient)
+	client.CustomUserAgent("terraform-provider-fivetran/" + version)
+
+	return &ProviderClient{
+		Client: client,
+		rest: &restClient{
+			baseURL:       baseURL,
+			authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte(apiKey+":"+apiSecret)),
+			httpClient:    httpClient,
+		},
+	}
+}
+
+// do sends the request with the JSON encoded body, a nil body sends no body. The error is returned when the
+// response status isn't expectedStatus, the response code and message are returned with it when available.
+func (c *restClient) do(ctx context.Context, method, path string, body interface{}, expectedStatus int) (restResponse, error) {
+	var response restResponse
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return response, err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reqBody)
+	if err != nil {
+		return response, err
+	}
+	req.Header.Set("Authorization", c.authorization)
+	req.Header.Set("User-Agent", "terraform-provider-fivetran/"+version)
+	req.Header.Set("Accept", "application/json;version=2")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return response, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return response, err
+	}
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return response, fmt.Errorf("status code: %v; unable to decode the response: %v", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != expectedStatus {
+		return response, fmt.Errorf("status code: %v; expected: %v", resp.StatusCode, expectedStatus)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,93 @@
+package fivetran
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The REST API returns the secrets masked, so the state keeps the salted hash of each secret sent to Fivetran
+// instead of its value. secretDiffSuppressFunc compares the configured value with the hash, the secrets are sent
+// again when they change in the configuration or when secrets_version changes.
+
+// maskedSecretValue is the value of the secrets set upstream in the REST API responses
+const maskedSecretValue = "******"
+
+// secretHashPrefix starts the salted hashes kept in the state: "sha256:<hex salt>:<hex hash>"
+const secretHashPrefix = "sha256:"
+
+const secretHashSaltSize = 16
+
+// secretHash returns the salted hash of value with a random salt
+func secretHash(value string) (string, error) {
+	salt := make([]byte, secretHashSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("unable to generate the secret hash salt: %v", err)
+	}
+	return secretHashWithSalt(salt, value), nil
+}
+
+func secretHashWithSalt(salt []byte, value string) string {
+	h := sha256.New()
+	h.Write(salt)
+	h.Write([]byte(value))
+	return secretHashPrefix + hex.EncodeToString(salt) + ":" + hex.EncodeToString(h.Sum(nil))
+}
+
+// isSecretHash reports whether the state value v is a salted hash
+func isSecretHash(v string) bool {
+	return strings.HasPrefix(v, secretHashPrefix)
+}
+
+// secretHashMatches reports whether hash is the salted hash of value
+func secretHashMatches(hash, value string) bool {
+	parts := strings.Split(strings.TrimPrefix(hash, secretHashPrefix), ":")
+	if len(parts) != 2 {
+		return false
+	}
+	salt, err := hex.DecodeString(parts[0])
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(secretHashWithSalt(salt, value)), []byte(hash)) == 1
+}
+
+// secretStateValue returns the state value of the secret v: the salted hash of a plain value, the hashes, the
+// masked values and the empty values are kept as they are
+func secretStateValue(v string) (string, error) {
+	if v == "" || v == maskedSecretValue || isSecretHash(v) {
+		return v, nil
+	}
+	return secretHash(v)
+}
+
+// secretIsPlain reports whether the secret v is a plain value that can be sent to the REST API
+func secretIsPlain(v string) bool {
+	return v != maskedSecretValue && !isSecretHash(v)
+}
+
+// secretDiffSuppressFunc suppresses the diff of a secret when the configured value matches the salted hash kept in
+// the state, or when the state keeps the masked value of an imported secret: its value is unknown and is assumed to
+// be the configured one. Nothing is suppressed when secrets_version changes, so all the configured secrets are sent
+// again.
+func secretDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" || secretsVersionChanged(d) {
+		return false
+	}
+	if old == maskedSecretValue {
+		return true
+	}
+	return isSecretHash(old) && secretHashMatches(old, new)
+}
+
+// secretsVersionChanged reports whether secrets_version changes from a previous version, setting it for the first
+// time, e.g. after an import, doesn't send the secrets again
+func secretsVersionChanged(d *schema.ResourceData) bool {
+	o, n := d.GetChange("secrets_version")
+	return o.(int) != 0 && o.(int) != n.(int)
+}
@@ -3,36 +3,42 @@ package mock
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
-	fivetranSdk "github.com/fivetran/go-fivetran"
 	"github.com/fivetran/go-fivetran/tests/mock"
 	"github.com/fivetran/terraform-provider-fivetran/fivetran"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-var client *fivetranSdk.Client
+var client *fivetran.ProviderClient
 var mockClient *mock.HttpClient
 var testProviders map[string]*schema.Provider
 
+// testImportProviders are the testProviders for the configurations with import blocks, the import block target
+// is resolved to the provider of the resource type
+var testImportProviders map[string]*schema.Provider
+
 var (
 	TEST_KEY    = "test_key"
 	TEST_SECRET = "test_secret"
 )
 
 func init() {
-	client = fivetranSdk.New(TEST_KEY, TEST_SECRET)
 	mockClient = mock.NewHttpClient()
-	client.BaseURL("https://api.fivetran.com/v1")
-	client.SetHttpClient(mockClient)
+	client = fivetran.NewClient(TEST_KEY, TEST_SECRET, "https://api.fivetran.com/v1", mockClient)
 
 	provider := fivetran.Provider()
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
@@ -43,6 +49,11 @@ func init() {
 		"fivetran-provider": provider,
 	}
 
+	testImportProviders = map[string]*schema.Provider{
+		"fivetran-provider": provider,
+		"fivetran":          provider,
+	}
+
 	if os.Getenv("TF_ACC") == "" {
 		// These are the mock tests, so we can freely set the TF_ACC environment variable
 		os.Setenv("TF_ACC", "True")
@@ -212,3 +223,28 @@ func createMapFromJsonString(t *testing.T, schemaJson string) map[string]interfa
 	}
 	return result
 }
+
+// testCheckResourceAttrSecretHash checks the state keeps the salted hash "sha256:<hex salt>:<hex hash>" of the
+// secret value instead of the value
+func testCheckResourceAttrSecretHash(name, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		actual := rs.Primary.Attributes[key]
+		parts := strings.Split(actual, ":")
+		if len(parts) != 3 || parts[0] != "sha256" {
+			return fmt.Errorf("%s: attribute '%s' expected to be a salted hash, got %q", name, key, actual)
+		}
+		salt, err := hex.DecodeString(parts[1])
+		if err != nil {
+			return fmt.Errorf("%s: attribute '%s' has an invalid salt: %v", name, key, err)
+		}
+		hash := sha256.Sum256(append(salt, value...))
+		if hex.EncodeToString(hash[:]) != parts[2] {
+			return fmt.Errorf("%s: attribute '%s' isn't the salted hash of %q", name, key, value)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,193 @@
+package mock
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	connectorSchemaDataSourceMockGetHandler *mock.Handler
+	connectorSchemaDataSourceMockData       map[string]interface{}
+)
+
+const (
+	connectorSchemaMappingResponse = `
+	{
+		"enable_new_by_default": false,
+		"schema_change_handling": "BLOCK_ALL",
+		"schemas": {
+			"schema_2": {
+				"name_in_destination": "schema_2",
+				"enabled": false,
+				"tables": {}
+			},
+			"schema_1": {
+				"name_in_destination": "schema_1_dest",
+				"enabled": true,
+				"tables": {
+					"table_2": {
+						"name_in_destination": "table_2",
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {
+							"allowed": false,
+							"reason_code": "SYSTEM_TABLE",
+							"reason": "The table is required by the connector"
+						},
+						"columns": {}
+					},
+					"table_1": {
+						"name_in_destination": "table_1_dest",
+						"enabled": false,
+						"sync_mode": "HISTORY",
+						"enabled_patch_settings": {
+							"allowed": true
+						},
+						"columns": {
+							"column_2": {
+								"name_in_destination": "column_2",
+								"enabled": true,
+								"hashed": false,
+								"enabled_patch_settings": {
+									"allowed": false,
+									"reason_code": "SYSTEM_COLUMN",
+									"reason": "The column is a primary key"
+								}
+							},
+							"column_1": {
+								"name_in_destination": "column_1_dest",
+								"enabled": true,
+								"hashed": true,
+								"enabled_patch_settings": {
+									"allowed": true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	`
+)
+
+func setupMockClientConnectorSchemaDataSourceConfigMapping(t *testing.T) {
+	mockClient.Reset()
+
+	connectorSchemaDataSourceMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorSchemaDataSourceMockData = createMapFromJsonString(t, connectorSchemaMappingResponse)
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorSchemaDataSourceMockData), nil
+		},
+	)
+}
+
+func TestDataSourceConnectorSchemaMappingMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connector_schema" "test_connector_schema" {
+			provider = fivetran-provider
+			id = "connector_id"
+		}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorSchemaDataSourceMockGetHandler.Interactions, 2)
+				assertNotEmpty(t, connectorSchemaDataSourceMockData)
+				return nil
+			},
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema_change_handling", "BLOCK_ALL"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.#", "2"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.name", "schema_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.name_in_destination", "schema_1_dest"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.enabled", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.#", "2"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.name", "table_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.name_in_destination", "table_1_dest"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.enabled", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.sync_mode", "HISTORY"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.enabled_patch_settings.0.allowed", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.enabled_patch_settings.0.reason_code", ""),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.#", "2"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.0.name", "column_1"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.0.name_in_destination", "column_1_dest"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.0.hashed", "true"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.1.name", "column_2"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.1.enabled_patch_settings.0.allowed", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.1.enabled_patch_settings.0.reason_code", "SYSTEM_COLUMN"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.0.column.1.enabled_patch_settings.0.reason", "The column is a primary key"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.1.name", "table_2"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.1.sync_mode", "SOFT_DELETE"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.1.enabled_patch_settings.0.allowed", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.1.enabled_patch_settings.0.reason_code", "SYSTEM_TABLE"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.0.table.1.enabled_patch_settings.0.reason", "The table is required by the connector"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.1.name", "schema_2"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.1.enabled", "false"),
+			resource.TestCheckResourceAttr("data.fivetran_connector_schema.test_connector_schema", "schema.1.table.#", "0"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorSchemaDataSourceConfigMapping(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
+
+func TestDataSourceConnectorSchemaNotFoundMock(t *testing.T) {
+	var reloadHandler *mock.Handler
+
+	step1 := resource.TestStep{
+		Config: `
+		data "fivetran_connector_schema" "test_connector_schema" {
+			provider = fivetran-provider
+			id = "connector_id"
+		}`,
+		ExpectError: regexp.MustCompile(`the connector connector_id has no schema config yet`),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				mockClient.Reset()
+				connectorSchemaDataSourceMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranResponse(t, req,
+							"NotFound_SchemaConfig", http.StatusNotFound,
+							"Connector with id 'connector_id' doesn't have schema config", nil), nil
+					},
+				)
+				reloadHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/schemas/reload").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranSuccessResponse(t, req, http.StatusOK, "Success", nil), nil
+					},
+				)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// the data source doesn't reload the schema config
+				assertEqual(t, reloadHandler.Interactions, 0)
+				return nil
+			},
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
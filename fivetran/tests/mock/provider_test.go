@@ -0,0 +1,689 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fivetran/terraform-provider-fivetran/fivetran"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// httpTestProviders returns providers configured by the real provider configure function,
+// so requests are performed over HTTP instead of the mock client.
+func httpTestProviders() map[string]*schema.Provider {
+	return map[string]*schema.Provider{
+		"fivetran-provider": fivetran.Provider(),
+	}
+}
+
+func writeFivetranResponse(t *testing.T, w http.ResponseWriter, code int, data map[string]interface{}) {
+	t.Helper()
+
+	respBody := map[string]interface{}{
+		"code": "Success",
+	}
+	if code >= 300 {
+		respBody["code"] = http.StatusText(code)
+	}
+	if data != nil {
+		respBody["data"] = data
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(respBody); err != nil {
+		t.Errorf("writeFivetranResponse, cannot encode JSON: %s", err)
+	}
+}
+
+func groupDataSourceConfig(providerConfig string) string {
+	return fmt.Sprintf(`
+		provider "fivetran-provider" {
+			%v
+		}
+
+		data "fivetran_group" "test_group" {
+			provider = fivetran-provider
+			id = "group_id"
+		}`, providerConfig)
+}
+
+func groupDataSourceHandler(t *testing.T, requests *int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/groups/group_id" {
+			writeFivetranResponse(t, w, http.StatusNotFound, nil)
+			return
+		}
+		if !strings.Contains(r.Header.Get("User-Agent"), "terraform-provider-fivetran/") {
+			t.Errorf("unexpected User-Agent: %v", r.Header.Get("User-Agent"))
+		}
+		writeFivetranResponse(t, w, http.StatusOK, createMapFromJsonString(t, groupMappingResponse))
+	}
+}
+
+func TestProviderApiUrlMock(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(groupDataSourceHandler(t, &requests))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`api_url = "%v/v1/"`, server.URL)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							assertEqual(t, requests > 0, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderProxyUrlMock(t *testing.T) {
+	requests := 0
+	handler := groupDataSourceHandler(t, &requests)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the proxy receives the absolute URL of the unreachable API host
+		assertEqual(t, r.URL.Host, "api.fivetran.invalid")
+		handler(w, r)
+	}))
+	defer proxy.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`
+						api_url   = "http://api.fivetran.invalid/v1"
+						proxy_url = "%v"`, proxy.URL)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							assertEqual(t, requests > 0, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderCaBundleMock(t *testing.T) {
+	requests := 0
+	server := httptest.NewTLSServer(groupDataSourceHandler(t, &requests))
+	defer server.Close()
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caBundle, certificate, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`
+						api_url   = "%v/v1"
+						ca_bundle = "%v"`, server.URL, caBundle)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							assertEqual(t, requests > 0, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderRequestTimeoutMock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`
+						api_url         = "%v/v1"
+						request_timeout = "100ms"
+						max_retries     = 0`, server.URL)),
+					ExpectError: regexp.MustCompile(`Client.Timeout exceeded`),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderRetryMock(t *testing.T) {
+	requests := 0
+	handler := groupDataSourceHandler(t, &requests)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch requests {
+		case 0:
+			requests++
+			w.Header().Set("Retry-After", "0")
+			writeFivetranResponse(t, w, http.StatusTooManyRequests, nil)
+		case 1:
+			requests++
+			writeFivetranResponse(t, w, http.StatusBadGateway, nil)
+		default:
+			handler(w, r)
+		}
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`
+						api_url        = "%v/v1"
+						max_retries    = 2
+						retry_wait_min = "1ms"
+						retry_wait_max = "10ms"`, server.URL)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							assertEqual(t, requests > 2, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderRetryNonIdempotentMock(t *testing.T) {
+	for retryNonIdempotent, expectedRequests := range map[bool]int{false: 1, true: 3} {
+		requests := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodPost && r.URL.Path == "/v1/groups" {
+				requests++
+			}
+			writeFivetranResponse(t, w, http.StatusServiceUnavailable, nil)
+		}))
+
+		resource.Test(
+			t,
+			resource.TestCase{
+				Providers: httpTestProviders(),
+				Steps: []resource.TestStep{
+					{
+						Config: fmt.Sprintf(`
+							provider "fivetran-provider" {
+								api_url              = "%v/v1"
+								max_retries          = 2
+								retry_wait_min       = "1ms"
+								retry_wait_max       = "10ms"
+								retry_non_idempotent = %v
+							}
+
+							resource "fivetran_group" "testgroup" {
+								provider = fivetran-provider
+								name = "test_group_name"
+							}`, server.URL, retryNonIdempotent),
+						ExpectError: regexp.MustCompile(`status code: 503`),
+					},
+				},
+			},
+		)
+
+		server.Close()
+		assertEqual(t, requests, expectedRequests)
+	}
+}
+
+// groupsDataSourcesConfig returns a config with count fivetran_group data sources read in parallel
+func groupsDataSourcesConfig(providerConfig string, count int) string {
+	result := fmt.Sprintf(`
+		provider "fivetran-provider" {
+			%v
+		}`, providerConfig)
+	for i := 0; i < count; i++ {
+		result += fmt.Sprintf(`
+
+		data "fivetran_group" "test_group_%v" {
+			provider = fivetran-provider
+			id = "group_id"
+		}`, i)
+	}
+	return result
+}
+
+func TestProviderMaxConcurrentRequestsMock(t *testing.T) {
+	var mutex sync.Mutex
+	inFlight, maxInFlight, requests := 0, 0, 0
+	handler := groupDataSourceHandler(t, &requests)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mutex.Unlock()
+
+		time.Sleep(20 * time.Millisecond)
+
+		mutex.Lock()
+		inFlight--
+		handler(w, r)
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupsDataSourcesConfig(fmt.Sprintf(`
+						api_url                 = "%v/v1"
+						max_concurrent_requests = 1`, server.URL), 5),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							assertEqual(t, requests >= 5, true)
+							assertEqual(t, maxInFlight, 1)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group_4", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderRequestsPerSecondMock(t *testing.T) {
+	var mutex sync.Mutex
+	var first, last time.Time
+	requests := 0
+	handler := groupDataSourceHandler(t, &requests)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		if first.IsZero() {
+			first = time.Now()
+		}
+		last = time.Now()
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupsDataSourcesConfig(fmt.Sprintf(`
+						api_url             = "%v/v1"
+						requests_per_second = 20`, server.URL), 5),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							mutex.Lock()
+							defer mutex.Unlock()
+							// each provider instance starts with a full bucket of 20 requests,
+							// the rest of the requests are sent at 20 per second at most
+							minElapsed := time.Duration(requests-20) * time.Second / 20
+							assertEqual(t, last.Sub(first) >= minElapsed*9/10, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group_4", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderDebugLoggingMock(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "%s.log")
+	t.Setenv("TF_LOG", "DEBUG")
+	t.Setenv("TF_LOG_PATH_MASK", logPath)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"code": "InvalidInput", "message": "Invalid password", "data": {"config": {"password": "response-password"}}}`)
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: fmt.Sprintf(`
+						provider "fivetran-provider" {
+							api_key     = "test_key"
+							api_secret  = "test-api-secret"
+							api_url     = "%v/v1"
+							max_retries = 0
+						}
+
+						resource "fivetran_destination" "mydestination" {
+							provider = fivetran-provider
+
+							group_id = "group_id"
+							service = "postgres_rds_warehouse"
+							time_zone_offset = "0"
+							region = "GCP_US_EAST4"
+
+							config {
+								host = "host"
+								password = "request-password"
+								private_key = "request-private-key"
+							}
+						}`, server.URL),
+					ExpectError: regexp.MustCompile(`InvalidInput`),
+				},
+			},
+		},
+	)
+
+	content, err := os.ReadFile(fmt.Sprintf(logPath, t.Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	logs := string(content)
+
+	for _, expected := range []string{`Fivetran API request`, `Fivetran API response`, `method=POST`, `path=/v1/destinations`, `status=400`, `code=InvalidInput`, `latency=`, `"password":"<redacted>"`, `"private_key":"<redacted>"`, `Authorization:<redacted>`} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("debug logs don't contain %q", expected)
+		}
+	}
+	for _, secret := range []string{"request-password", "request-private-key", "response-password", "test-api-secret", "Basic "} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("debug logs contain the secret %q", secret)
+		}
+	}
+}
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestProviderCredentialsMock(t *testing.T) {
+	credentialsFile := writeCredentialsFile(t, `
+		# shared Fivetran credentials
+		[default]
+		api_key    = default_key
+		api_secret = default_secret
+
+		[staging]
+		api_key    = "staging_key"
+		api_secret = "staging_secret"
+	`)
+
+	for _, testCase := range []struct {
+		name           string
+		providerConfig string
+		env            map[string]string
+		expectedKey    string
+		expectedSecret string
+	}{
+		{
+			name:           "provider arguments take precedence over profile",
+			providerConfig: `api_key = "hcl_key"` + "\n" + `api_secret = "hcl_secret"` + "\n" + `profile = "staging"`,
+			env:            map[string]string{"FIVETRAN_APIKEY": "env_key", "FIVETRAN_APISECRET": "env_secret"},
+			expectedKey:    "hcl_key",
+			expectedSecret: "hcl_secret",
+		},
+		{
+			name:           "profile argument takes precedence over environment variables",
+			providerConfig: `profile = "staging"`,
+			env:            map[string]string{"FIVETRAN_APIKEY": "env_key", "FIVETRAN_APISECRET": "env_secret"},
+			expectedKey:    "staging_key",
+			expectedSecret: "staging_secret",
+		},
+		{
+			name:           "profile selected by environment variable",
+			env:            map[string]string{"FIVETRAN_PROFILE": "staging", "FIVETRAN_APIKEY": "env_key", "FIVETRAN_APISECRET": "env_secret"},
+			expectedKey:    "staging_key",
+			expectedSecret: "staging_secret",
+		},
+		{
+			name:           "environment variables take precedence over default profile",
+			env:            map[string]string{"FIVETRAN_APIKEY": "env_key", "FIVETRAN_APISECRET": "env_secret"},
+			expectedKey:    "env_key",
+			expectedSecret: "env_secret",
+		},
+		{
+			name:           "default profile",
+			env:            map[string]string{"FIVETRAN_APIKEY": "", "FIVETRAN_APISECRET": ""},
+			expectedKey:    "default_key",
+			expectedSecret: "default_secret",
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv("FIVETRAN_PROFILE", "")
+			for name, value := range testCase.env {
+				t.Setenv(name, value)
+			}
+
+			requests := 0
+			handler := groupDataSourceHandler(t, &requests)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				key, secret, _ := r.BasicAuth()
+				assertEqual(t, key, testCase.expectedKey)
+				assertEqual(t, secret, testCase.expectedSecret)
+				handler(w, r)
+			}))
+			defer server.Close()
+
+			resource.Test(
+				t,
+				resource.TestCase{
+					Providers: httpTestProviders(),
+					Steps: []resource.TestStep{
+						{
+							Config: groupDataSourceConfig(fmt.Sprintf(`
+								api_url          = "%v/v1"
+								credentials_file = "%v"
+								%v`, server.URL, credentialsFile, testCase.providerConfig)),
+							Check: resource.ComposeAggregateTestCheckFunc(
+								func(s *terraform.State) error {
+									assertEqual(t, requests > 0, true)
+									return nil
+								},
+								resource.TestCheckResourceAttr("data.fivetran_group.test_group", "name", "group_name"),
+							),
+						},
+					},
+				},
+			)
+		})
+	}
+}
+
+func TestProviderCredentialsSourceNoDiagnosticMock(t *testing.T) {
+	credentialsFile := writeCredentialsFile(t, `
+		[staging]
+		api_key    = staging_key
+		api_secret = staging_secret
+	`)
+	t.Setenv("FIVETRAN_PROFILE", "")
+
+	// the credentials source is only logged, so it doesn't show up on every plan and apply
+	for name, config := range map[string]map[string]interface{}{
+		"provider arguments": {"api_key": "hcl_key", "api_secret": "hcl_secret"},
+		"profile":            {"credentials_file": credentialsFile, "profile": "staging"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			diags := fivetran.Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+			assertEqual(t, len(diags), 0)
+		})
+	}
+}
+
+func TestProviderCredentialsErrorsMock(t *testing.T) {
+	credentialsFile := writeCredentialsFile(t, `
+		[default]
+		api_key = default_key
+
+		[staging]
+		api_key    = staging_key
+		api_secret = staging_secret
+	`)
+
+	for _, testCase := range []struct {
+		name           string
+		providerConfig string
+		expectedError  string
+	}{
+		{
+			name:           "unknown profile",
+			providerConfig: fmt.Sprintf(`credentials_file = "%v"`+"\n"+`profile = "production"`, credentialsFile),
+			expectedError:  `profile "production" not found in credentials file`,
+		},
+		{
+			name:           "incomplete profile",
+			providerConfig: fmt.Sprintf(`credentials_file = "%v"`, credentialsFile),
+			expectedError:  `api_secret is missing in profile "default" of credentials file`,
+		},
+		{
+			name:           "missing credentials file",
+			providerConfig: fmt.Sprintf(`credentials_file = "%v"`, filepath.Join(t.TempDir(), "credentials")),
+			expectedError:  `no credentials found`,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Setenv("FIVETRAN_PROFILE", "")
+			t.Setenv("FIVETRAN_APIKEY", "")
+			t.Setenv("FIVETRAN_APISECRET", "")
+
+			resource.Test(
+				t,
+				resource.TestCase{
+					Providers: httpTestProviders(),
+					Steps: []resource.TestStep{
+						{
+							Config:      groupDataSourceConfig(testCase.providerConfig),
+							ExpectError: regexp.MustCompile(regexp.QuoteMeta(testCase.expectedError)),
+						},
+					},
+				},
+			)
+		})
+	}
+}
+
+func TestProviderValidateCredentialsMock(t *testing.T) {
+	validationRequests := 0
+	requests := 0
+	handler := groupDataSourceHandler(t, &requests)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v1/groups" {
+			validationRequests++
+			assertEqual(t, r.URL.Query().Get("limit"), "1")
+			writeFivetranResponse(t, w, http.StatusOK, map[string]interface{}{"items": []interface{}{}})
+			return
+		}
+		handler(w, r)
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`
+						api_key              = "validated_key"
+						api_secret           = "validated_secret"
+						api_url              = "%v/v1"
+						validate_credentials = true`, server.URL)),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							// the provider is configured for each Terraform command, the validation result is cached
+							assertEqual(t, validationRequests, 1)
+							assertEqual(t, requests > 0, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("data.fivetran_group.test_group", "name", "group_name"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestProviderValidateCredentialsFailureMock(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assertEqual(t, r.URL.Path, "/v1/groups")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"code": "AuthFailed", "message": "Invalid API key or secret"}`)
+	}))
+	defer server.Close()
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			Providers: httpTestProviders(),
+			Steps: []resource.TestStep{
+				{
+					Config: groupDataSourceConfig(fmt.Sprintf(`
+						api_key              = "invalid_key"
+						api_secret           = "invalid_secret"
+						api_url              = "%v/v1"
+						validate_credentials = true`, server.URL)),
+					ExpectError: regexp.MustCompile(`invalid credentials(.|\n)*provider\s+arguments\s+api_key\s+and\s+api_secret(.|\n)*AuthFailed`),
+				},
+			},
+		},
+	)
+
+	assertEqual(t, requests, 1)
+}
@@ -0,0 +1,240 @@
+package mock
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	connectorResyncMockGetHandler             *mock.Handler
+	connectorResyncMockResyncHandler          *mock.Handler
+	connectorResyncMockConnectorResyncHandler *mock.Handler
+	connectorResyncMockData                   map[string]interface{}
+	connectorResyncMockTables                 []string
+)
+
+// setupMockClientConnectorResyncResource stubs the connector endpoints, the historical sync started by
+// re-sync finishes after historicalSyncPolls connector details requests.
+func setupMockClientConnectorResyncResource(t *testing.T, historicalSyncPolls int) {
+	mockClient.Reset()
+	connectorResyncMockData = createMapFromJsonString(t, connectorWithoutConfig)
+	connectorResyncMockData["status"].(map[string]interface{})["is_historical_sync"] = false
+	connectorResyncMockTables = nil
+	polls := 0
+
+	connectorResyncMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			status := connectorResyncMockData["status"].(map[string]interface{})
+			if status["is_historical_sync"] == true {
+				polls++
+				if polls == historicalSyncPolls {
+					status["is_historical_sync"] = false
+				}
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorResyncMockData), nil
+		},
+	)
+
+	connectorResyncMockConnectorResyncHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/resync").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			polls = 0
+			connectorResyncMockData["status"].(map[string]interface{})["is_historical_sync"] = true
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Re-sync has been triggered successfully", nil), nil
+		},
+	)
+
+	connectorResyncMockResyncHandler = mockClient.WhenWc(http.MethodPost, "/v1/connectors/connector_id/schemas/*/tables/*/resync").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			path := strings.Split(req.URL.Path, "/")
+			connectorResyncMockTables = append(connectorResyncMockTables, path[5]+"."+path[7])
+			polls = 0
+			connectorResyncMockData["status"].(map[string]interface{})["is_historical_sync"] = true
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Re-sync has been triggered successfully", nil), nil
+		},
+	)
+}
+
+func TestResourceConnectorResyncTablesMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_connector_resync" "test_resync" {
+				provider = fivetran-provider
+
+				connector_id = "connector_id"
+				tables = ["public.users", "sales.orders"]
+				wait_for_completion = true
+				poll_interval = "10ms"
+				triggers = {
+					backfill = "1"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorResyncMockResyncHandler.Interactions, 2)
+				sort.Strings(connectorResyncMockTables)
+				assertEqual(t, connectorResyncMockTables, []string{"public.users", "sales.orders"})
+				assertEqual(t, connectorResyncMockGetHandler.Interactions > 3, true)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_resync.test_resync", "id", "connector_id"),
+			resource.TestCheckResourceAttr("fivetran_connector_resync.test_resync", "is_historical_sync", "false"),
+			resource.TestCheckResourceAttr("fivetran_connector_resync.test_resync", "resynced_tables.#", "2"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_connector_resync" "test_resync" {
+				provider = fivetran-provider
+
+				connector_id = "connector_id"
+				tables = ["public.users", "sales.orders"]
+				wait_for_completion = true
+				poll_interval = "10ms"
+				triggers = {
+					backfill = "2"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorResyncMockResyncHandler.Interactions, 4)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_resync.test_resync", "is_historical_sync", "false"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResyncResource(t, 3)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// destroy doesn't trigger any requests
+				assertEqual(t, connectorResyncMockResyncHandler.Interactions, 4)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorResyncConnectorMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResyncResource(t, 1)
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "fivetran_connector_resync" "test_resync" {
+							provider = fivetran-provider
+
+							connector_id = "connector_id"
+						}`,
+
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							// the whole connector is re-synced by a single request, the schema config isn't read
+							assertEqual(t, connectorResyncMockConnectorResyncHandler.Interactions, 1)
+							assertEqual(t, connectorResyncMockResyncHandler.Interactions, 0)
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector_resync.test_resync", "id", "connector_id"),
+						resource.TestCheckResourceAttr("fivetran_connector_resync.test_resync", "resynced_tables.#", "0"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestResourceConnectorResyncTableFailureMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResyncResource(t, 1)
+				mockClient.When(http.MethodPost, "/v1/connectors/connector_id/schemas/sales/tables/orders/resync").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranResponse(t, req, "NotFound_Table", http.StatusNotFound, "Table not found", nil), nil
+					},
+				)
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "fivetran_connector_resync" "test_resync" {
+							provider = fivetran-provider
+
+							connector_id = "connector_id"
+							tables = ["public.users", "sales.orders"]
+						}`,
+
+					ExpectError: regexp.MustCompile(`table sales.orders re-sync(.|\n)*the re-sync of tables public.users has already\s+been triggered`),
+				},
+			},
+		},
+	)
+
+	assertEqual(t, connectorResyncMockTables, []string{"public.users"})
+}
+
+func TestResourceConnectorResyncIncrementalSyncMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResyncResource(t, 1)
+				// an incremental sync finishes, the historical sync of the re-sync doesn't start
+				connectorResyncMockConnectorResyncHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/resync").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						connectorResyncMockData["succeeded_at"] = "2099-01-01T00:00:00.000Z"
+						return fivetranSuccessResponse(t, req, http.StatusOK, "Re-sync has been triggered successfully", nil), nil
+					},
+				)
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "fivetran_connector_resync" "test_resync" {
+							provider = fivetran-provider
+
+							connector_id = "connector_id"
+							wait_for_completion = true
+							poll_interval = "10ms"
+
+							timeouts {
+								create = "1s"
+							}
+						}`,
+
+					ExpectError: regexp.MustCompile(`wait for re-sync error(.|\n)*the historical sync started: false`),
+				},
+			},
+		},
+	)
+}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/fivetran/go-fivetran/tests/mock"
@@ -22,9 +23,86 @@ var (
 	schemaHashedAlignmentGetHandler   *mock.Handler
 	schemaHashedAlignmentPatchHandler *mock.Handler
 	schemaHashedAlignmentData         map[string]interface{}
+
+	schemaImportGetHandler   *mock.Handler
+	schemaImportPatchHandler *mock.Handler
+	schemaImportData         map[string]interface{}
 )
 
 const (
+	schemaImportJsonSchema = `
+	{
+		"schema_change_handling": "ALLOW_ALL",
+		"schemas": {
+			"schema_1": {
+				"name_in_destination": "schema_1",
+				"enabled": true,
+				"tables": {
+					"table_1": {
+						"name_in_destination": "table_1",
+						"enabled": false,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {
+							"allowed": true
+						},
+						"columns": {
+							"column_1": {
+								"name_in_destination": "column_1",
+								"enabled": true,
+								"hashed": false,
+								"enabled_patch_settings": {
+									"allowed": true
+								}
+							}
+						}
+					},
+					"table_2": {
+						"name_in_destination": "table_2",
+						"enabled": true,
+						"sync_mode": "HISTORY",
+						"enabled_patch_settings": {
+							"allowed": true
+						},
+						"columns": {}
+					},
+					"table_3": {
+						"name_in_destination": "table_3",
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {
+							"allowed": true
+						},
+						"columns": {
+							"column_1": {
+								"name_in_destination": "column_1",
+								"enabled": true,
+								"hashed": true,
+								"enabled_patch_settings": {
+									"allowed": true
+								}
+							}
+						}
+					},
+					"table_4": {
+						"name_in_destination": "table_4",
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {
+							"allowed": true
+						},
+						"columns": {}
+					}
+				}
+			},
+			"schema_2": {
+				"name_in_destination": "schema_2",
+				"enabled": false,
+				"tables": {}
+			}
+		}
+	}
+	`
+
 	schemaHashedColumnAlignmentJsonSchema = `
 	{
 		"enable_new_by_default": true,
@@ -838,3 +916,573 @@ func TestResourceLockedSchemaMock(t *testing.T) {
 		},
 	)
 }
+
+func setupMockClientImportSchemaResource(t *testing.T) {
+	mockClient.Reset()
+	schemaImportData = createMapFromJsonString(t, schemaImportJsonSchema)
+
+	schemaImportGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaImportData), nil
+		},
+	)
+
+	schemaImportPatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id/schemas/").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, schemaImportJsonSchema)), nil
+		},
+	)
+}
+
+// This test checks that the imported schema config contains the upstream items that aren't aligned with the SCH
+// policy and the tables with a non-default sync mode, so the plan after the import is empty
+func TestResourceSchemaImportMock(t *testing.T) {
+	config := `
+			import {
+				to = fivetran_connector_schema_config.test_schema
+				id = "connector_id"
+			}
+
+			resource "fivetran_connector_schema_config" "test_schema" {
+				provider = fivetran-provider
+				connector_id = "connector_id"
+				schema_change_handling = "ALLOW_ALL"
+				schema {
+					name = "schema_1"
+					table {
+						name = "table_1"
+						enabled = "false"
+					}
+					table {
+						name = "table_2"
+						sync_mode = "HISTORY"
+					}
+					table {
+						name = "table_3"
+						column {
+							name = "column_1"
+							hashed = "true"
+						}
+					}
+				}
+				schema {
+					name = "schema_2"
+					enabled = "false"
+				}
+			}`
+
+	step1 := resource.TestStep{
+		Config: config,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, schemaImportPatchHandler.Interactions, 0) // nothing to update after the import
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "connector_id", "connector_id"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "schema.#", "2"),
+		),
+	}
+
+	// the sync mode changed outside of Terraform is read on refresh and reported as a drift
+	step2 := resource.TestStep{
+		PreConfig: func() {
+			table := schemaImportData["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})["table_2"]
+			table.(map[string]interface{})["sync_mode"] = "LIVE"
+		},
+		Config:             config,
+		PlanOnly:           true,
+		ExpectNonEmptyPlan: true,
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientImportSchemaResource(t)
+			},
+			Providers: testImportProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// there is no possibility to destroy schema config - it alsways exists within the connector
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+var (
+	schemaRulesGetHandler   *mock.Handler
+	schemaRulesPatchHandler *mock.Handler
+	schemaRulesData         map[string]interface{}
+	schemaRulesRequests     []map[string]interface{}
+)
+
+const schemaRulesJsonSchema = `
+	{
+		"schema_change_handling": "ALLOW_ALL",
+		"schemas": {
+			"schema_1": {
+				"enabled": true,
+				"tables": {
+					"tmp_1": {
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {"allowed": true},
+						"columns": {}
+					},
+					"tmp_2": {
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {"allowed": true},
+						"columns": {}
+					},
+					"users": {
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {"allowed": true},
+						"columns": {
+							"id": {"enabled": true, "hashed": false, "enabled_patch_settings": {"allowed": true}},
+							"email": {"enabled": true, "hashed": false, "enabled_patch_settings": {"allowed": true}}
+						}
+					}
+				}
+			}
+		}
+	}
+	`
+
+// mergeSchemaPatch applies the schemas of the patch request to the schema config the way Fivetran does it
+func mergeSchemaPatch(target, patch map[string]interface{}) {
+	for k, v := range patch {
+		if vmap, ok := v.(map[string]interface{}); ok {
+			if tmap, ok := target[k].(map[string]interface{}); ok {
+				mergeSchemaPatch(tmap, vmap)
+				continue
+			}
+		}
+		target[k] = v
+	}
+}
+
+func setupMockClientSchemaRulesResource(t *testing.T) {
+	mockClient.Reset()
+	schemaRulesData = createMapFromJsonString(t, schemaRulesJsonSchema)
+	schemaRulesRequests = nil
+
+	schemaRulesGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaRulesData), nil
+		},
+	)
+
+	schemaRulesPatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id/schemas/").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			body := requestBodyToJson(t, req)
+			schemaRulesRequests = append(schemaRulesRequests, body)
+			mergeSchemaPatch(schemaRulesData["schemas"].(map[string]interface{}), body["schemas"].(map[string]interface{}))
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaRulesData), nil
+		},
+	)
+}
+
+func TestResourceSchemaRulesMock(t *testing.T) {
+	config := `
+		resource "fivetran_connector_schema_config" "test_schema" {
+			provider = fivetran-provider
+			connector_id = "connector_id"
+			schema_change_handling = "ALLOW_ALL"
+
+			table_rule {
+				match = "schema_1.tmp_*"
+				enabled = "false"
+			}
+			column_rule {
+				match = "^.*\\.(email|phone)$"
+				match_type = "regex"
+				hashed = "true"
+			}
+
+			schema {
+				name = "schema_1"
+				table {
+					name = "tmp_2"
+				}
+			}
+		}`
+
+	assertLastPatch := func() {
+		tables := schemaRulesRequests[len(schemaRulesRequests)-1]["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})
+		assertEqual(t, tables["tmp_1"].(map[string]interface{})["enabled"], false)
+		// the explicit entry overrides the rule
+		if tmp2, ok := tables["tmp_2"].(map[string]interface{}); ok {
+			assertEqual(t, tmp2["enabled"], true)
+		}
+	}
+
+	step1 := resource.TestStep{
+		Config: config,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, schemaRulesPatchHandler.Interactions, 1)
+				assertLastPatch()
+				tables := schemaRulesRequests[0]["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})
+				email := tables["users"].(map[string]interface{})["columns"].(map[string]interface{})["email"].(map[string]interface{})
+				assertEqual(t, email["hashed"], true)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "schema.#", "1"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.#", "2"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.0.rule", "table_rule.0"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.0.matches.#", "2"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.0.matches.0", "schema_1.tmp_1"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.0.matches.1", "schema_1.tmp_2"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.0.match_count", "2"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.1.rule", "column_rule.0"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.1.matches.#", "1"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.1.matches.0", "schema_1.users.email"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "rule_matches.1.match_count", "1"),
+		),
+	}
+
+	// the table enabled outside of Terraform is disabled again by the rule
+	step2 := resource.TestStep{
+		PreConfig: func() {
+			schemaRulesData["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})["tmp_1"].(map[string]interface{})["enabled"] = true
+		},
+		Config: config,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, schemaRulesPatchHandler.Interactions, 2)
+				assertLastPatch()
+				return nil
+			},
+		),
+	}
+
+	step3 := resource.TestStep{
+		Config: `
+		resource "fivetran_connector_schema_config" "test_schema" {
+			provider = fivetran-provider
+			connector_id = "connector_id"
+			schema_change_handling = "ALLOW_ALL"
+
+			table_rule {
+				match = "schema_1.[invalid"
+				enabled = "false"
+			}
+		}`,
+		ExpectError: regexp.MustCompile(`table_rule.0.match: invalid glob pattern "schema_1.\[invalid"`),
+	}
+
+	// the glob segments don't match a dot, a column pattern needs the schema, table and column segments
+	step4 := resource.TestStep{
+		Config: `
+		resource "fivetran_connector_schema_config" "test_schema" {
+			provider = fivetran-provider
+			connector_id = "connector_id"
+			schema_change_handling = "ALLOW_ALL"
+
+			column_rule {
+				match = "*.email"
+				hashed = "true"
+			}
+		}`,
+		ExpectError: regexp.MustCompile(`column_rule.0.match: the glob pattern "\*.email" must have 3 dot separated segments`),
+	}
+
+	// a table pattern needs exactly the schema and table segments
+	step5 := resource.TestStep{
+		Config: `
+		resource "fivetran_connector_schema_config" "test_schema" {
+			provider = fivetran-provider
+			connector_id = "connector_id"
+			schema_change_handling = "ALLOW_ALL"
+
+			table_rule {
+				match = "schema_1.users.email"
+				enabled = "false"
+			}
+		}`,
+		ExpectError: regexp.MustCompile(`table_rule.0.match: the glob pattern "schema_1.users.email" must have 2 dot separated segments`),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientSchemaRulesResource(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// there is no possibility to destroy schema config - it alsways exists within the connector
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+				step3,
+				step4,
+				step5,
+			},
+		},
+	)
+}
+
+var (
+	schemaSettingsGetHandler    *mock.Handler
+	schemaSettingsPatchHandler  *mock.Handler
+	schemaSettingsData          map[string]interface{}
+	schemaSettingsPatchRequests []map[string]interface{}
+)
+
+const schemaSettingsJsonSchema = `
+	{
+		"schema_change_handling": "ALLOW_ALL",
+		"schemas": {
+			"schema_1": {
+				"name_in_destination": "schema_1",
+				"enabled": true,
+				"tables": {
+					"table_1": {
+						"name_in_destination": "table_1",
+						"enabled": true,
+						"sync_mode": "SOFT_DELETE",
+						"enabled_patch_settings": {
+							"allowed": true
+						},
+						"columns": {
+							"id": {
+								"name_in_destination": "id",
+								"enabled": true,
+								"hashed": false,
+								"is_primary_key": false,
+								"enabled_patch_settings": {
+									"allowed": true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	`
+
+func setupMockClientSchemaSettingsResource(t *testing.T) {
+	mockClient.Reset()
+	schemaSettingsData = createMapFromJsonString(t, schemaSettingsJsonSchema)
+	schemaSettingsPatchRequests = nil
+
+	schemaSettingsGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaSettingsData), nil
+		},
+	)
+
+	schemaSettingsPatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id/schemas/").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			body := requestBodyToJson(t, req)
+			schemaSettingsPatchRequests = append(schemaSettingsPatchRequests, body)
+			mergeSchemaPatch(schemaSettingsData["schemas"].(map[string]interface{}), body["schemas"].(map[string]interface{}))
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", schemaSettingsData), nil
+		},
+	)
+}
+
+func TestResourceSchemaSettingsMock(t *testing.T) {
+	config := `
+		resource "fivetran_connector_schema_config" "test_schema" {
+			provider = fivetran-provider
+			connector_id = "connector_id"
+			schema_change_handling = "ALLOW_ALL"
+
+			schema {
+				name = "schema_1"
+				table {
+					name = "table_1"
+					sync_mode = "HISTORY"
+					column {
+						name = "id"
+						is_primary_key = "true"
+					}
+				}
+			}
+		}`
+
+	// the primary key is sent in the same request as the other settings
+	step1 := resource.TestStep{
+		Config: config,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, schemaSettingsPatchHandler.Interactions, 1)
+				table := schemaSettingsPatchRequests[0]["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})["table_1"].(map[string]interface{})
+				assertEqual(t, table["sync_mode"], "HISTORY")
+				assertEqual(t, table["columns"].(map[string]interface{})["id"].(map[string]interface{})["is_primary_key"], true)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "schema.0.table.0.sync_mode", "HISTORY"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "schema.0.table.0.column.0.is_primary_key", "true"),
+		),
+	}
+
+	// the primary key changed outside of Terraform is set again
+	step2 := resource.TestStep{
+		PreConfig: func() {
+			table := schemaSettingsData["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})["table_1"].(map[string]interface{})
+			table["columns"].(map[string]interface{})["id"].(map[string]interface{})["is_primary_key"] = false
+		},
+		Config: config,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, schemaSettingsPatchHandler.Interactions, 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "schema.0.table.0.column.0.is_primary_key", "true"),
+		),
+	}
+
+	// the primary key not returned by Fivetran shows in the plan
+	step3 := resource.TestStep{
+		PreConfig: func() {
+			table := schemaSettingsData["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{})["tables"].(map[string]interface{})["table_1"].(map[string]interface{})
+			delete(table["columns"].(map[string]interface{})["id"].(map[string]interface{}), "is_primary_key")
+		},
+		Config:             config,
+		PlanOnly:           true,
+		ExpectNonEmptyPlan: true,
+	}
+
+	step4 := resource.TestStep{
+		Config: `
+		resource "fivetran_connector_schema_config" "test_schema" {
+			provider = fivetran-provider
+			connector_id = "connector_id"
+			schema_change_handling = "ALLOW_ALL"
+
+			schema {
+				name = "schema_1"
+				table {
+					name = "table_1"
+					column {
+						name = "id"
+						enabled = "false"
+						is_primary_key = "true"
+					}
+				}
+			}
+		}`,
+		ExpectError: regexp.MustCompile(`column schema_1.table_1.id: a primary key column can't be disabled`),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientSchemaSettingsResource(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// there is no possibility to destroy schema config - it alsways exists within the connector
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+				step3,
+				step4,
+			},
+		},
+	)
+}
+
+func TestResourceSchemaOnDestroyMock(t *testing.T) {
+	// the patch sent on destroy for each on_destroy value, keep doesn't send any patch
+	checks := map[string]func(schema map[string]interface{}){
+		"keep": nil,
+		"reset_to_sch_default": func(schema map[string]interface{}) {
+			tables := schema["tables"].(map[string]interface{})
+			// the items are restored to the ALLOW_ALL default
+			assertEqual(t, tables["tmp_1"].(map[string]interface{})["enabled"], true)
+			email := tables["users"].(map[string]interface{})["columns"].(map[string]interface{})["email"].(map[string]interface{})
+			assertEqual(t, email["enabled"], true)
+			assertEqual(t, email["hashed"], false)
+		},
+		"disable_all": func(schema map[string]interface{}) {
+			assertEqual(t, schema["enabled"], false)
+			tables := schema["tables"].(map[string]interface{})
+			assertEqual(t, len(tables), 3)
+			for _, table := range tables {
+				assertEqual(t, table.(map[string]interface{})["enabled"], false)
+				assertEqual(t, table.(map[string]interface{})["columns"], nil)
+			}
+		},
+	}
+
+	for onDestroy, check := range checks {
+		check := check
+		resource.Test(
+			t,
+			resource.TestCase{
+				PreCheck: func() {
+					setupMockClientSchemaRulesResource(t)
+				},
+				Providers: testProviders,
+				CheckDestroy: func(s *terraform.State) error {
+					if check == nil {
+						assertEqual(t, schemaRulesPatchHandler.Interactions, 1)
+						return nil
+					}
+					assertEqual(t, schemaRulesPatchHandler.Interactions, 2)
+					check(schemaRulesRequests[1]["schemas"].(map[string]interface{})["schema_1"].(map[string]interface{}))
+					return nil
+				},
+
+				Steps: []resource.TestStep{
+					{
+						Config: `
+						resource "fivetran_connector_schema_config" "test_schema" {
+							provider = fivetran-provider
+							connector_id = "connector_id"
+							schema_change_handling = "ALLOW_ALL"
+							on_destroy = "` + onDestroy + `"
+
+							schema {
+								name = "schema_1"
+								table {
+									name = "tmp_1"
+									enabled = "false"
+								}
+								table {
+									name = "users"
+									column {
+										name = "email"
+										hashed = "true"
+									}
+								}
+							}
+						}`,
+
+						Check: resource.ComposeAggregateTestCheckFunc(
+							func(s *terraform.State) error {
+								assertEqual(t, schemaRulesPatchHandler.Interactions, 1)
+								return nil
+							},
+							resource.TestCheckResourceAttr("fivetran_connector_schema_config.test_schema", "on_destroy", onDestroy),
+						),
+					},
+				},
+			},
+		)
+	}
+}
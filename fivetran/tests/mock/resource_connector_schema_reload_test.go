@@ -0,0 +1,207 @@
+package mock
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	connectorSchemaReloadMockReloadHandler *mock.Handler
+	connectorSchemaReloadMockGetHandler    *mock.Handler
+	connectorSchemaReloadMockData          map[string]interface{}
+	connectorSchemaReloadMockExcludeMode   interface{}
+)
+
+const connectorSchemaReloadBeforeResponse = `
+{
+	"schema_change_handling": "BLOCK_ALL",
+	"schemas": {
+		"schema_1": {
+			"name_in_destination": "schema_1",
+			"enabled": true,
+			"tables": {
+				"table_1": {"name_in_destination": "table_1", "enabled": true}
+			}
+		}
+	}
+}`
+
+const connectorSchemaReloadAfterResponse = `
+{
+	"schema_change_handling": "BLOCK_ALL",
+	"schemas": {
+		"schema_1": {
+			"name_in_destination": "schema_1",
+			"enabled": true,
+			"tables": {
+				"table_1": {"name_in_destination": "table_1", "enabled": true},
+				"table_2": {"name_in_destination": "table_2", "enabled": false}
+			}
+		},
+		"schema_2": {
+			"name_in_destination": "schema_2",
+			"enabled": false,
+			"tables": {
+				"table_3": {"name_in_destination": "table_3", "enabled": false}
+			}
+		}
+	}
+}`
+
+// setupMockClientConnectorSchemaReloadResource stubs the connector and schema endpoints, the first
+// gatewayTimeouts reload requests fail with a non-JSON gateway timeout response while the reload goes on.
+func setupMockClientConnectorSchemaReloadResource(t *testing.T, gatewayTimeouts int) {
+	mockClient.Reset()
+	connectorSchemaReloadMockData = createMapFromJsonString(t, connectorSchemaReloadBeforeResponse)
+	connectorSchemaReloadMockExcludeMode = nil
+
+	mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", createMapFromJsonString(t, connectorWithoutConfig)), nil
+		},
+	)
+
+	connectorSchemaReloadMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id/schemas").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorSchemaReloadMockData), nil
+		},
+	)
+
+	connectorSchemaReloadMockReloadHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/schemas/reload").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorSchemaReloadMockExcludeMode = requestBodyToJson(t, req)["exclude_mode"]
+			connectorSchemaReloadMockData = createMapFromJsonString(t, connectorSchemaReloadAfterResponse)
+			if gatewayTimeouts > 0 {
+				gatewayTimeouts--
+				return mock.NewResponse(req, http.StatusGatewayTimeout, "<html><body>504 Gateway Time-out</body></html>"), nil
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorSchemaReloadMockData), nil
+		},
+	)
+}
+
+func connectorSchemaReloadConfig(trigger string) string {
+	return `
+		resource "fivetran_connector_schema_reload" "test_reload" {
+			provider = fivetran-provider
+
+			connector_id = "connector_id"
+			exclude_mode = "EXCLUDE"
+			triggers = {
+				tables = "` + trigger + `"
+			}
+		}`
+}
+
+func TestResourceConnectorSchemaReloadMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorSchemaReloadConfig("1"),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorSchemaReloadMockReloadHandler.Interactions, 1)
+				assertEqual(t, connectorSchemaReloadMockGetHandler.Interactions, 1)
+				assertEqual(t, connectorSchemaReloadMockExcludeMode, "EXCLUDE")
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_schema_reload.test_reload", "id", "connector_id"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_reload.test_reload", "new_tables.#", "2"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_reload.test_reload", "new_tables.0", "schema_1.table_2"),
+			resource.TestCheckResourceAttr("fivetran_connector_schema_reload.test_reload", "new_tables.1", "schema_2.table_3"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: connectorSchemaReloadConfig("2"),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorSchemaReloadMockReloadHandler.Interactions, 2)
+				return nil
+			},
+			// nothing new is discovered by the second reload
+			resource.TestCheckResourceAttr("fivetran_connector_schema_reload.test_reload", "new_tables.#", "0"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorSchemaReloadResource(t, 0)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// destroy doesn't trigger any requests
+				assertEqual(t, connectorSchemaReloadMockReloadHandler.Interactions, 2)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorSchemaReloadErrorMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorSchemaReloadResource(t, 0)
+				connectorSchemaReloadMockReloadHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/schemas/reload").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						return fivetranResponse(t, req, "NotFound_Connector", http.StatusNotFound, "Connector with id 'connector_id' doesn't exist", nil), nil
+					},
+				)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// the API errors are not retried
+				assertEqual(t, connectorSchemaReloadMockReloadHandler.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				{
+					Config:      connectorSchemaReloadConfig("1"),
+					ExpectError: regexp.MustCompile(`schema reload error`),
+				},
+			},
+		},
+	)
+}
+
+// the reload that can't be confirmed as finished, e.g. after a gateway timeout, fails the creation
+func TestResourceConnectorSchemaReloadGatewayTimeoutMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorSchemaReloadResource(t, 1)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// the reload isn't requested again and the schema config isn't read after the failed reload
+				assertEqual(t, connectorSchemaReloadMockReloadHandler.Interactions, 1)
+				assertEqual(t, connectorSchemaReloadMockGetHandler.Interactions, 1)
+				assertEqual(t, len(s.RootModule().Resources), 0)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				{
+					Config:      connectorSchemaReloadConfig("1"),
+					ExpectError: regexp.MustCompile(`schema reload error`),
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,145 @@
+package mock
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+var (
+	connectorSyncMockGetHandler   *mock.Handler
+	connectorSyncMockForceHandler *mock.Handler
+	connectorSyncMockData         map[string]interface{}
+)
+
+// setupMockClientConnectorSyncResource stubs the connector endpoints, each sync finishes after
+// syncPolls connector details requests with the finishedField (succeeded_at or failed_at) set.
+func setupMockClientConnectorSyncResource(t *testing.T, syncPolls int, finishedField string) {
+	mockClient.Reset()
+	connectorSyncMockData = createMapFromJsonString(t, connectorWithoutConfig)
+	polls, syncs := 0, 0
+
+	connectorSyncMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			status := connectorSyncMockData["status"].(map[string]interface{})
+			if status["sync_state"] == "syncing" {
+				polls++
+				if polls == syncPolls {
+					status["sync_state"] = "scheduled"
+					connectorSyncMockData[finishedField] = fmt.Sprintf("2022-01-0%vT11:22:33.012345Z", syncs+1)
+				}
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorSyncMockData), nil
+		},
+	)
+
+	connectorSyncMockForceHandler = mockClient.When(http.MethodPost, "/v1/connectors/connector_id/force").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			polls = 0
+			syncs++
+			connectorSyncMockData["status"].(map[string]interface{})["sync_state"] = "syncing"
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Sync has been successfully triggered for connector with id 'connector_id'", nil), nil
+		},
+	)
+}
+
+func TestResourceConnectorSyncMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: `
+			resource "fivetran_connector_sync" "test_sync" {
+				provider = fivetran-provider
+
+				connector_id = "connector_id"
+				poll_interval = "10ms"
+				triggers = {
+					config = "1"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorSyncMockForceHandler.Interactions, 1)
+				assertEqual(t, connectorSyncMockGetHandler.Interactions > 3, true)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_sync.test_sync", "id", "connector_id"),
+			resource.TestCheckResourceAttr("fivetran_connector_sync.test_sync", "sync_state", "scheduled"),
+			resource.TestCheckResourceAttr("fivetran_connector_sync.test_sync", "succeeded_at", "2022-01-02 11:22:33.012345 +0000 UTC"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: `
+			resource "fivetran_connector_sync" "test_sync" {
+				provider = fivetran-provider
+
+				connector_id = "connector_id"
+				poll_interval = "10ms"
+				triggers = {
+					config = "2"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorSyncMockForceHandler.Interactions, 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector_sync.test_sync", "sync_state", "scheduled"),
+			resource.TestCheckResourceAttr("fivetran_connector_sync.test_sync", "succeeded_at", "2022-01-03 11:22:33.012345 +0000 UTC"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorSyncResource(t, 3, "succeeded_at")
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				// destroy doesn't trigger any requests
+				assertEqual(t, connectorSyncMockForceHandler.Interactions, 2)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorSyncFailedMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorSyncResource(t, 2, "failed_at")
+				connectorSyncMockData["status"].(map[string]interface{})["warnings"] = []interface{}{
+					map[string]interface{}{"code": "skipped_tables", "message": "Some tables were skipped"},
+				}
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "fivetran_connector_sync" "test_sync" {
+							provider = fivetran-provider
+
+							connector_id = "connector_id"
+							poll_interval = "10ms"
+						}`,
+					ExpectError: regexp.MustCompile(`connector sync failed(.|\n)*warning skipped_tables: Some tables were skipped`),
+				},
+			},
+		},
+	)
+}
@@ -1,10 +1,13 @@
 package mock
 
 import (
+	"context"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/fivetran/go-fivetran/tests/mock"
+	"github.com/fivetran/terraform-provider-fivetran/fivetran"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
@@ -538,13 +541,13 @@ const (
 			conversion_window_size = "0"
 			skip_before = "0"
 			skip_after = "0"
-			ftp_port = "0"
-			sftp_port = "0"
-			port = "0"
-			tunnel_port = "0"
+			ftp_port = "21"
+			sftp_port = "22"
+			port = "5432"
+			tunnel_port = "2222"
 			api_quota = "0"
 			daily_api_call_limit = "0"
-			agent_port = "0"
+			agent_port = "4343"
 
 			pdb_name = "pdb_name"
 			agent_host = "agent_host"
@@ -937,6 +940,19 @@ func setupMockClientConnectorResourceListMappingConfig(t *testing.T) {
 	)
 }
 
+// connectorResourceMappingResponse returns connectorMappingResponse with the port numbers of
+// connectorConfigMappingTfConfig, the resource config rejects the 0 port numbers
+func connectorResourceMappingResponse(t *testing.T) map[string]interface{} {
+	response := createMapFromJsonString(t, connectorMappingResponse)
+	config := response["config"].(map[string]interface{})
+	config["ftp_port"] = 21
+	config["sftp_port"] = 22
+	config["port"] = 5432
+	config["tunnel_port"] = 2222
+	config["agent_port"] = 4343
+	return response
+}
+
 func setupMockClientConnectorResourceConfigMapping(t *testing.T) {
 	mockClient.Reset()
 
@@ -1040,11 +1056,11 @@ func setupMockClientConnectorResourceConfigMapping(t *testing.T) {
 			assertKeyExistsAndHasValue(t, config, "conversion_window_size", float64(0))
 			assertKeyExistsAndHasValue(t, config, "skip_before", float64(0))
 			assertKeyExistsAndHasValue(t, config, "skip_after", float64(0))
-			assertKeyExistsAndHasValue(t, config, "ftp_port", float64(0))
-			assertKeyExistsAndHasValue(t, config, "sftp_port", float64(0))
-			assertKeyExistsAndHasValue(t, config, "port", float64(0))
-			assertKeyExistsAndHasValue(t, config, "agent_port", float64(0))
-			assertKeyExistsAndHasValue(t, config, "tunnel_port", float64(0))
+			assertKeyExistsAndHasValue(t, config, "ftp_port", float64(21))
+			assertKeyExistsAndHasValue(t, config, "sftp_port", float64(22))
+			assertKeyExistsAndHasValue(t, config, "port", float64(5432))
+			assertKeyExistsAndHasValue(t, config, "agent_port", float64(4343))
+			assertKeyExistsAndHasValue(t, config, "tunnel_port", float64(2222))
 			assertKeyExistsAndHasValue(t, config, "api_quota", float64(0))
 			assertKeyExistsAndHasValue(t, config, "daily_api_call_limit", float64(0))
 
@@ -1344,7 +1360,7 @@ func setupMockClientConnectorResourceConfigMapping(t *testing.T) {
 			assertKeyExistsAndHasValue(t, function_secret, "key", "key")
 			assertKeyExistsAndHasValue(t, function_secret, "value", "value")
 
-			connectorMockData = createMapFromJsonString(t, connectorMappingResponse)
+			connectorMockData = connectorResourceMappingResponse(t)
 			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", connectorMockData), nil
 		},
 	)
@@ -1457,32 +1473,32 @@ func TestResourceConnectorConfigMappingMock(t *testing.T) {
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "trust_fingerprints", "false"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "run_setup_tests", "false"),
 
-			// check sensitive fields are have original values
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.oauth_token", "oauth_token"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.oauth_token_secret", "oauth_token_secret"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.consumer_key", "consumer_key"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.client_secret", "client_secret"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.private_key", "private_key"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.s3role_arn", "s3role_arn"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.ftp_password", "ftp_password"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.sftp_password", "sftp_password"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.api_key", "api_key"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.role_arn", "role_arn"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.password", "password"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.secret_key", "secret_key"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.pem_certificate", "pem_certificate"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.access_token", "access_token"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.api_secret", "api_secret"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.api_access_token", "api_access_token"),
+			// check the state keeps the salted hashes of the sensitive fields, the computed secret keeps its value
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.oauth_token", "oauth_token"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.oauth_token_secret", "oauth_token_secret"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.consumer_key", "consumer_key"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.client_secret", "client_secret"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.private_key", "private_key"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.s3role_arn", "s3role_arn"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.ftp_password", "ftp_password"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.sftp_password", "sftp_password"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.api_key", "api_key"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.role_arn", "role_arn"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.password", "password"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.secret_key", "secret_key"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.pem_certificate", "pem_certificate"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.access_token", "access_token"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.api_secret", "api_secret"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.api_access_token", "api_access_token"),
 			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.secret", "secret"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.consumer_secret", "consumer_secret"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.secrets", "secrets"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.api_token", "api_token"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.encryption_key", "encryption_key"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.pat", "pat"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.function_trigger", "function_trigger"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.token_key", "token_key"),
-			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.token_secret", "token_secret"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.consumer_secret", "consumer_secret"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.secrets", "secrets"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.api_token", "api_token"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.encryption_key", "encryption_key"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.pat", "pat"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.function_trigger", "function_trigger"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.token_key", "token_key"),
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.token_secret", "token_secret"),
 		),
 	}
 
@@ -1691,3 +1707,1122 @@ func TestResourceConnectorListsConfigMock(t *testing.T) {
 		},
 	)
 }
+
+const connectorWaitForSetupConfig = `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "postgres"
+
+		destination_schema {
+			prefix = "postgres"
+		}
+
+		sync_frequency = 5
+		paused = true
+		pause_after_trial = true
+		run_setup_tests = true
+
+		wait_for_setup = true
+		setup_poll_interval = "10ms"
+	}`
+
+func setupMockClientConnectorResourceWaitForSetup(t *testing.T, connectedAfter int, status string) {
+	mockClient.Reset()
+
+	connectorMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			if connectorMockGetHandler.Interactions == connectedAfter {
+				connectorMockData["status"].(map[string]interface{})["setup_state"] = "connected"
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = createMapFromJsonString(t, connectorWithoutConfig)
+			connectorMockData["status"] = createMapFromJsonString(t, status)
+			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockDelete = mockClient.When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = nil
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+}
+
+func TestResourceConnectorWaitForSetupMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceWaitForSetup(t, 3, `{"setup_state": "incomplete", "tasks": [], "warnings": []}`)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				{
+					Config: connectorWaitForSetupConfig,
+					Check: resource.ComposeAggregateTestCheckFunc(
+						func(s *terraform.State) error {
+							assertEqual(t, connectorMockPostHandler.Interactions, 1)
+							assertEqual(t, connectorMockGetHandler.Interactions > 3, true)
+							return nil
+						},
+						resource.TestCheckResourceAttr("fivetran_connector.test_connector", "status.0.setup_state", "connected"),
+					),
+				},
+			},
+		},
+	)
+}
+
+func TestResourceConnectorWaitForSetupFailedMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceWaitForSetup(t, -1, `
+				{
+					"setup_state": "incomplete",
+					"tasks": [{"code": "reconnect", "message": "Invalid login credentials"}],
+					"warnings": [{"code": "resync", "message": "Table will be resynced"}]
+				}`)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				{
+					Config:      connectorWaitForSetupConfig,
+					ExpectError: regexp.MustCompile(`connector setup failed(.|\n)*task reconnect: Invalid login credentials(.|\n)*warning resync: Table will be resynced`),
+				},
+			},
+		},
+	)
+}
+
+func connectorConfigJsonConfig(configJson string) string {
+	return `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "postgres"
+
+		destination_schema {
+			prefix = "postgres"
+		}
+
+		sync_frequency = 5
+		paused = true
+		pause_after_trial = true
+		run_setup_tests = false
+
+		config {
+			user = "user_name"
+		}
+
+		config_json = jsonencode(` + configJson + `)
+	}`
+}
+
+var connectorConfigJsonMockRequestConfig map[string]interface{}
+
+func setupMockClientConnectorResourceConfigJson(t *testing.T) {
+	mockClient.Reset()
+
+	// the response masks the secret, returns the port as a number and adds the keys not managed by config_json
+	applyRequestConfig := func(req *http.Request) {
+		config := requestBodyToJson(t, req)["config"].(map[string]interface{})
+		connectorConfigJsonMockRequestConfig = config
+		responseConfig := connectorMockData["config"].(map[string]interface{})
+		for k, v := range config {
+			responseConfig[k] = v
+		}
+		responseConfig["secret_token"] = "******"
+		responseConfig["port"] = 5432
+		responseConfig["update_method"] = "XMIN"
+	}
+
+	connectorMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = createMapFromJsonString(t, connectorWithoutConfig)
+			applyRequestConfig(req)
+			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockUpdatePatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			applyRequestConfig(req)
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockDelete = mockClient.When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = nil
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+}
+
+func TestResourceConnectorConfigJsonMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorConfigJsonConfig(`{ custom_option = "value1", port = "5432", secret_token = "token" }`),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockPostHandler.Interactions, 1)
+				config := connectorConfigJsonMockRequestConfig
+				assertEqual(t, config["custom_option"], "value1")
+				assertEqual(t, config["port"], "5432")
+				assertEqual(t, config["secret_token"], "token")
+				assertEqual(t, config["user"], "user_name")
+				return nil
+			},
+			// only the keys set in config_json are read back, masked and re-typed values don't drift
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config_json",
+				`{"custom_option":"value1","port":"5432","secret_token":"token"}`),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.user", "user_name"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config.0.update_method", "XMIN"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: connectorConfigJsonConfig(`{ custom_option = "value2", port = "5432", secret_token = "token" }`),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 1)
+				assertEqual(t, connectorMockData["config"].(map[string]interface{})["custom_option"], "value2")
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "config_json",
+				`{"custom_option":"value2","port":"5432","secret_token":"token"}`),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceConfigJson(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorConfigJsonConflictMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceConfigJson(t)
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config:      connectorConfigJsonConfig(`{ user = "other_user", port = 5432 }`),
+					ExpectError: regexp.MustCompile(`config keys set in both config and config_json: user`),
+				},
+			},
+		},
+	)
+}
+
+func connectorServiceValidationConfig(config string) string {
+	return `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "postgres"
+
+		destination_schema {
+			prefix = "postgres"
+		}
+
+		sync_frequency = 5
+		paused = true
+		pause_after_trial = true
+		run_setup_tests = false
+
+		config {
+			` + config + `
+		}
+	}`
+}
+
+func TestResourceConnectorConfigServiceValidationMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				mockClient.Reset()
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config:      connectorServiceValidationConfig(`update_method = "incremental"`),
+					ExpectError: regexp.MustCompile(`config.0.update_method: expected one of \[WAL, XMIN, WAL_PGOUTPUT,\s+TELEPORT\] for service postgres, got: "incremental"`),
+				},
+				{
+					// the fields set by Fivetran are only reported as warnings on apply
+					Config:             connectorServiceValidationConfig(`public_key = "public_key"`),
+					PlanOnly:           true,
+					ExpectNonEmptyPlan: true,
+				},
+			},
+		},
+	)
+}
+
+func connectorTypedFieldsConfig(syncFrequency, paused string) string {
+	return `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "postgres"
+
+		destination_schema {
+			prefix = "postgres"
+		}
+
+		sync_frequency = ` + syncFrequency + `
+		paused = ` + paused + `
+		pause_after_trial = true
+	}`
+}
+
+func TestResourceConnectorTypedFieldsValidationMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				mockClient.Reset()
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config:      connectorTypedFieldsConfig(`7`, `true`),
+					ExpectError: regexp.MustCompile(`expected sync_frequency to be one of \[5 15 30 60 120 180 360 480 720 1440\], got 7`),
+				},
+				{
+					Config:      connectorTypedFieldsConfig(`"abc"`, `true`),
+					ExpectError: regexp.MustCompile(`Inappropriate value for attribute "sync_frequency": a number is required`),
+				},
+				{
+					Config:      connectorTypedFieldsConfig(`5`, `"yes"`),
+					ExpectError: regexp.MustCompile(`Inappropriate value for attribute "paused": a bool is required`),
+				},
+			},
+		},
+	)
+}
+
+func TestResourceConnectorStateUpgradeV0Mock(t *testing.T) {
+	upgrader := fivetran.Provider().ResourcesMap["fivetran_connector"].StateUpgraders[0]
+	assertEqual(t, upgrader.Version, 0)
+
+	state, err := upgrader.Upgrade(context.Background(), map[string]interface{}{
+		"id":                 "connector_id",
+		"sync_frequency":     "1440",
+		"paused":             "true",
+		"pause_after_trial":  "false",
+		"trust_certificates": "",
+		"config": []interface{}{
+			map[string]interface{}{
+				"user":      "user_name",
+				"port":      "5432",
+				"is_ftps":   "false",
+				"api_quota": "",
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEqual(t, state["id"], "connector_id")
+	assertEqual(t, state["sync_frequency"], 1440)
+	assertEqual(t, state["paused"], true)
+	assertEqual(t, state["pause_after_trial"], false)
+	assertEqual(t, state["trust_certificates"], nil)
+	config := state["config"].([]interface{})[0].(map[string]interface{})
+	assertEqual(t, config["user"], "user_name")
+	assertEqual(t, config["port"], 5432)
+	assertEqual(t, config["is_ftps"], false)
+	assertEqual(t, config["api_quota"], nil)
+
+	state, err = upgrader.Upgrade(context.Background(), map[string]interface{}{
+		"paused": "yes",
+		"config": []interface{}{map[string]interface{}{"port": "abc", "is_ftps": "true"}},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertEqual(t, state["paused"], nil)
+	config = state["config"].([]interface{})[0].(map[string]interface{})
+	assertEqual(t, config["port"], nil)
+	assertEqual(t, config["is_ftps"], true)
+}
+
+func connectorDestinationSchemaConfig(service, destinationSchema string) string {
+	return connectorGroupConfig("group_id", service, destinationSchema)
+}
+
+func connectorGroupConfig(groupID, service, destinationSchema string) string {
+	return `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "` + groupID + `"
+		service = "` + service + `"
+
+		destination_schema {
+			` + destinationSchema + `
+		}
+
+		sync_frequency = 5
+		paused = true
+		pause_after_trial = true
+	}`
+}
+
+var connectorDestinationSchemaMockRequests []map[string]interface{}
+
+func setupMockClientConnectorResourceDestinationSchema(t *testing.T, service string) {
+	mockClient.Reset()
+	connectorDestinationSchemaMockRequests = nil
+
+	// the response schema is built the way Fivetran does it: prefix, or name with an optional table
+	applyRequestSchema := func(req *http.Request) {
+		body := requestBodyToJson(t, req)
+		connectorDestinationSchemaMockRequests = append(connectorDestinationSchemaMockRequests, body)
+		config, _ := body["config"].(map[string]interface{})
+		if v, ok := config["schema_prefix"]; ok {
+			connectorMockData["schema"] = v
+		}
+		if v, ok := config["schema"]; ok {
+			connectorMockData["schema"] = v
+			if table, ok := config["table"]; ok {
+				connectorMockData["schema"] = v.(string) + "." + table.(string)
+			}
+		}
+	}
+
+	connectorMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = createMapFromJsonString(t, connectorWithoutConfig)
+			connectorMockData["service"] = service
+			connectorMockData["group_id"] = requestBodyToJson(t, req)["group_id"]
+			applyRequestSchema(req)
+			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockUpdatePatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			applyRequestSchema(req)
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockDelete = mockClient.When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = nil
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+}
+
+func TestResourceConnectorDestinationSchemaReplaceMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorDestinationSchemaConfig("google_sheets", `
+			name = "schema_a"
+			table = "table_a"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockPostHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "name", "schema_a.table_a"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: connectorDestinationSchemaConfig("google_sheets", `
+			name = "schema_b"
+			table = "table_b"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// the destination schema isn't renamed in place, the connector is replaced
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 0)
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				assertEqual(t, connectorMockPostHandler.Interactions, 2)
+				config := connectorDestinationSchemaMockRequests[1]["config"].(map[string]interface{})
+				assertKeyExistsAndHasValue(t, config, "schema", "schema_b")
+				assertKeyExistsAndHasValue(t, config, "table", "table_b")
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "name", "schema_b.table_b"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "destination_schema.0.name", "schema_b"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "destination_schema.0.table", "table_b"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceDestinationSchema(t, "google_sheets")
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 2)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorDestinationSchemaPrefixReplaceMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorDestinationSchemaConfig("postgres", `prefix = "prefix_a"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "destination_schema.0.prefix", "prefix_a"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: connectorDestinationSchemaConfig("postgres", `prefix = "prefix_b"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// the prefix of a database connector can't be renamed, the connector is replaced
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 0)
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				assertEqual(t, connectorMockPostHandler.Interactions, 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "destination_schema.0.prefix", "prefix_b"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceDestinationSchema(t, "postgres")
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 2)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorGroupMovedMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorGroupConfig("group_a", "google_sheets", `name = "schema"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_a"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		PreConfig: func() {
+			// the connector is moved to another group outside of Terraform, e.g. by Fivetran support
+			connectorMockData["group_id"] = "group_b"
+		},
+		Config: connectorGroupConfig("group_b", "google_sheets", `name = "schema"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// the refresh reads the new group, so the plan has no changes
+				assertEqual(t, connectorMockPostHandler.Interactions, 1)
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 0)
+				assertEqual(t, connectorMockDelete.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "id", "connector_id"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_b"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceDestinationSchema(t, "google_sheets")
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorGroupReplaceMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorGroupConfig("group_a", "google_sheets", `name = "schema"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_a"),
+		),
+	}
+
+	step2 := resource.TestStep{
+		Config: connectorGroupConfig("group_b", "google_sheets", `name = "schema"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// the REST API can't move the connector, it is replaced
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				assertEqual(t, connectorMockPostHandler.Interactions, 2)
+				assertKeyExistsAndHasValue(t, connectorDestinationSchemaMockRequests[1], "group_id", "group_b")
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_b"),
+		),
+	}
+
+	// the connector moved outside of Terraform is imported with the same state as the created one
+	step3 := resource.TestStep{
+		ResourceName:      "fivetran_connector.test_connector",
+		ImportState:       true,
+		ImportStateId:     "connector_id",
+		ImportStateVerify: true,
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceDestinationSchema(t, "google_sheets")
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 2)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+				step3,
+			},
+		},
+	)
+}
+
+func TestResourceConnectorGroupImportMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorGroupConfig("group_a", "google_sheets", `name = "schema"`),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "group_id", "group_a"),
+		),
+	}
+
+	// the connector recreated in the new group outside of Terraform is imported instead of being replaced,
+	// the imported state matches the configuration with the new group_id
+	step2 := resource.TestStep{
+		PreConfig: func() {
+			movedConnector := make(map[string]interface{})
+			for k, v := range connectorMockData {
+				movedConnector[k] = v
+			}
+			movedConnector["id"] = "moved_connector_id"
+			movedConnector["group_id"] = "group_b"
+			mockClient.When(http.MethodGet, "/v1/connectors/moved_connector_id").ThenCall(
+				func(req *http.Request) (*http.Response, error) {
+					return fivetranSuccessResponse(t, req, http.StatusOK, "Success", movedConnector), nil
+				},
+			)
+		},
+		ResourceName:  "fivetran_connector.test_connector",
+		ImportState:   true,
+		ImportStateId: "moved_connector_id",
+		ImportStateCheck: func(states []*terraform.InstanceState) error {
+			assertEqual(t, len(states), 1)
+			assertEqual(t, states[0].ID, "moved_connector_id")
+			assertEqual(t, states[0].Attributes["group_id"], "group_b")
+			assertEqual(t, states[0].Attributes["destination_schema.0.name"], "schema")
+			assertEqual(t, states[0].Attributes["wait_for_setup"], "false")
+			// the import doesn't touch the connector, so its sync state is kept
+			assertEqual(t, connectorMockPostHandler.Interactions, 1)
+			assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 0)
+			assertEqual(t, connectorMockDelete.Interactions, 0)
+			return nil
+		},
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceDestinationSchema(t, "google_sheets")
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+func setupMockClientConnectorResourceImport(t *testing.T) {
+	setupMockClientConnectorResourceDestinationSchema(t, "google_sheets")
+
+	listPage := func(items []interface{}, nextCursor interface{}) map[string]interface{} {
+		return map[string]interface{}{"items": items, "next_cursor": nextCursor}
+	}
+
+	mockClient.When(http.MethodGet, "/v1/groups").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			items := []interface{}{
+				map[string]interface{}{"id": "other_group_id", "name": "other_group", "created_at": "2018-12-20T11:59:35.089589Z"},
+			}
+			if req.URL.Query().Get("cursor") == "" {
+				return fivetranSuccessResponse(t, req, http.StatusOK, "Success", listPage(items, "groups_cursor")), nil
+			}
+			items = []interface{}{
+				map[string]interface{}{"id": "group_id", "name": "group_name", "created_at": "2018-12-20T11:59:35.089589Z"},
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", listPage(items, nil)), nil
+		},
+	)
+
+	mockClient.When(http.MethodGet, "/v1/groups/group_id/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("cursor") == "" {
+				items := []interface{}{
+					map[string]interface{}{"id": "sheets_id", "group_id": "group_id", "service": "google_sheets", "schema": "sheets"},
+					map[string]interface{}{"id": "schemas_id", "group_id": "group_id", "service": "google_sheets", "schema": "schemas"},
+				}
+				return fivetranSuccessResponse(t, req, http.StatusOK, "Success", listPage(items, "connectors_cursor")), nil
+			}
+			items := []interface{}{
+				map[string]interface{}{"id": "connector_id", "group_id": "group_id", "service": "google_sheets", "schema": "schema"},
+			}
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", listPage(items, nil)), nil
+		},
+	)
+}
+
+func TestResourceConnectorImportBySchemaNameMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: connectorDestinationSchemaConfig("google_sheets", `name = "schema"`),
+	}
+
+	importStep := func(id string) resource.TestStep {
+		return resource.TestStep{
+			ResourceName:      "fivetran_connector.test_connector",
+			ImportState:       true,
+			ImportStateId:     id,
+			ImportStateVerify: true,
+		}
+	}
+
+	step5 := importStep("group_name/schem")
+	step5.ExpectError = regexp.MustCompile(`connector with schema name "schem" not found in group group_id, did\s+you\s+mean:\s+schema,\s+schemas\?`)
+
+	step6 := importStep("group_nme/schema")
+	step6.ExpectError = regexp.MustCompile(`group "group_nme" not found, did you mean: group_name\?`)
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceImport(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				importStep("connector_id"),
+				importStep("group_id/schema"),
+				importStep("group_name/schema"),
+				step5,
+				step6,
+			},
+		},
+	)
+}
+
+func connectorSecretsConfig(secretsVersion, user, password string) string {
+	return `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "postgres"
+		secrets_version = ` + secretsVersion + `
+
+		destination_schema {
+			prefix = "postgres"
+		}
+
+		sync_frequency = 5
+		paused = true
+		pause_after_trial = true
+
+		config {
+			user = "` + user + `"
+			password = "` + password + `"
+		}
+
+		auth {
+			refresh_token = "refresh_token"
+			access_token = "access_token"
+			client_access {
+				client_id = "client_id"
+				client_secret = "client_secret"
+			}
+		}
+	}`
+}
+
+var connectorSecretsMockRequests []map[string]interface{}
+
+func setupMockClientConnectorResourceSecrets(t *testing.T) {
+	mockClient.Reset()
+	connectorSecretsMockRequests = nil
+
+	// Fivetran returns the secrets masked
+	applyRequestConfig := func(req *http.Request) {
+		body := requestBodyToJson(t, req)
+		connectorSecretsMockRequests = append(connectorSecretsMockRequests, body)
+		config := connectorMockData["config"].(map[string]interface{})
+		for k, v := range body["config"].(map[string]interface{}) {
+			if k == "password" {
+				v = "******"
+			}
+			config[k] = v
+		}
+	}
+
+	connectorMockGetHandler = mockClient.When(http.MethodGet, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = createMapFromJsonString(t, connectorWithoutConfig)
+			applyRequestConfig(req)
+			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockUpdatePatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			applyRequestConfig(req)
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+
+	connectorMockDelete = mockClient.When(http.MethodDelete, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = nil
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", connectorMockData), nil
+		},
+	)
+}
+
+func TestResourceConnectorSecretsMock(t *testing.T) {
+	lastRequestConfig := func() map[string]interface{} {
+		return connectorSecretsMockRequests[len(connectorSecretsMockRequests)-1]["config"].(map[string]interface{})
+	}
+	lastRequestAuth := func() map[string]interface{} {
+		return connectorSecretsMockRequests[len(connectorSecretsMockRequests)-1]["auth"].(map[string]interface{})
+	}
+	authSecretHashes := resource.ComposeAggregateTestCheckFunc(
+		testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "auth.0.refresh_token", "refresh_token"),
+		testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "auth.0.access_token", "access_token"),
+		testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "auth.0.client_access.0.client_secret", "client_secret"),
+		resource.TestCheckResourceAttr("fivetran_connector.test_connector", "auth.0.client_access.0.client_id", "client_id"),
+	)
+
+	step1 := resource.TestStep{
+		Config: connectorSecretsConfig("1", "user", "password"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertKeyExistsAndHasValue(t, lastRequestConfig(), "password", "password")
+				assertKeyExistsAndHasValue(t, lastRequestAuth(), "refresh_token", "refresh_token")
+				return nil
+			},
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.password", "password"),
+			authSecretHashes,
+		),
+	}
+
+	// the unchanged secrets aren't sent with the other config changes
+	step2 := resource.TestStep{
+		Config: connectorSecretsConfig("1", "user_2", "password"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 1)
+				assertKeyExistsAndHasValue(t, lastRequestConfig(), "user", "user_2")
+				if _, ok := lastRequestConfig()["password"]; ok {
+					t.Error("the unchanged password is expected not to be sent")
+				}
+				if _, ok := lastRequestAuth()["refresh_token"]; ok {
+					t.Error("the unchanged refresh_token is expected not to be sent")
+				}
+				return nil
+			},
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.password", "password"),
+			authSecretHashes,
+		),
+	}
+
+	// the secret rotated outside of Terraform is sent again after a secrets_version change
+	step3 := resource.TestStep{
+		Config: connectorSecretsConfig("2", "user_2", "password"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 2)
+				assertKeyExistsAndHasValue(t, lastRequestConfig(), "password", "password")
+				assertKeyExistsAndHasValue(t, lastRequestAuth(), "refresh_token", "refresh_token")
+				return nil
+			},
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.password", "password"),
+		),
+	}
+
+	// the secret cleared outside of Terraform is a drift
+	step4 := resource.TestStep{
+		PreConfig: func() {
+			connectorMockData["config"].(map[string]interface{})["password"] = ""
+		},
+		Config: connectorSecretsConfig("2", "user_2", "password"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 3)
+				assertKeyExistsAndHasValue(t, lastRequestConfig(), "password", "password")
+				return nil
+			},
+			testCheckResourceAttrSecretHash("fivetran_connector.test_connector", "config.0.password", "password"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceSecrets(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+				step3,
+				step4,
+			},
+		},
+	)
+}
+
+func connectorSetupTestsConfig(failOnWarning, syncFrequency string) string {
+	return `
+	resource "fivetran_connector" "test_connector" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "google_sheets"
+		run_setup_tests = true
+		fail_on_setup_test_warning = ` + failOnWarning + `
+
+		destination_schema {
+			name = "schema"
+		}
+
+		sync_frequency = ` + syncFrequency + `
+		paused = true
+		pause_after_trial = true
+	}`
+}
+
+func setupMockClientConnectorResourceSetupTests(t *testing.T) {
+	setupMockClientConnectorResourceDestinationSchema(t, "google_sheets")
+
+	setupTests := []interface{}{
+		map[string]interface{}{"title": "Validate Login", "status": "PASSED", "message": ""},
+		map[string]interface{}{"title": "Validate Permissions", "status": "WARNING", "message": "Missing permissions"},
+	}
+
+	connectorMockPostHandler = mockClient.When(http.MethodPost, "/v1/connectors").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			connectorMockData = createMapFromJsonString(t, connectorWithoutConfig)
+			connectorMockData["service"] = "google_sheets"
+			connectorMockData["schema"] = "schema"
+			response := copyMockData(connectorMockData)
+			response["setup_tests"] = setupTests
+			return fivetranSuccessResponse(t, req, http.StatusCreated, "Success", response), nil
+		},
+	)
+
+	connectorMockUpdatePatchHandler = mockClient.When(http.MethodPatch, "/v1/connectors/connector_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			body := requestBodyToJson(t, req)
+			connectorMockData["sync_frequency"] = body["sync_frequency"]
+			response := copyMockData(connectorMockData)
+			response["setup_tests"] = setupTests
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Success", response), nil
+		},
+	)
+}
+
+func copyMockData(data map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		result[k] = v
+	}
+	return result
+}
+
+func TestResourceConnectorSetupTestsMock(t *testing.T) {
+	// the setup tests warnings are reported as warnings by default
+	step1 := resource.TestStep{
+		Config: connectorSetupTestsConfig("false", "5"),
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, connectorMockPostHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "setup_tests.#", "2"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "setup_tests.0.title", "Validate Login"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "setup_tests.0.status", "PASSED"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "setup_tests.1.title", "Validate Permissions"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "setup_tests.1.status", "WARNING"),
+			resource.TestCheckResourceAttr("fivetran_connector.test_connector", "setup_tests.1.message", "Missing permissions"),
+		),
+	}
+
+	// fail_on_setup_test_warning turns the warnings into errors
+	step2 := resource.TestStep{
+		Config:      connectorSetupTestsConfig("true", "15"),
+		ExpectError: regexp.MustCompile(`setup test warning[\s\S]*Validate Permissions: Missing permissions`),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceSetupTests(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockUpdatePatchHandler.Interactions, 1)
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				step2,
+			},
+		},
+	)
+}
+
+// fail_on_setup_test_warning deletes the connector created with failed setup tests, it isn't added to the state
+func TestResourceConnectorSetupTestsCreateFailedMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config:      connectorSetupTestsConfig("true", "5"),
+		ExpectError: regexp.MustCompile(`setup test warning[\s\S]*Validate Permissions: Missing permissions`),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientConnectorResourceSetupTests(t)
+			},
+			Providers: testProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, connectorMockPostHandler.Interactions, 1)
+				assertEqual(t, connectorMockDelete.Interactions, 1)
+				assertEqual(t, len(s.RootModule().Resources), 0)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
@@ -21,6 +21,8 @@ var (
 	destinationMappingGetHandler    *mock.Handler
 	destinationMappingPostHandler   *mock.Handler
 	destinationMappingDeleteHandler *mock.Handler
+
+	destinationImportPatchHandler *mock.Handler
 )
 
 const (
@@ -376,7 +378,7 @@ func TestResourceDestinationMock(t *testing.T) {
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.host", "terraform-test.us-east-1.rds.amazonaws.com"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.port", "5432"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.user", "postgres"),
-			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.password", "password"),
+			testCheckResourceAttrSecretHash("fivetran_destination.mydestination", "config.0.password", "password"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.database", "fivetran"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.connection_type", "Directly"),
 		),
@@ -417,7 +419,7 @@ func TestResourceDestinationMock(t *testing.T) {
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.host", "test.host"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.port", "5434"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.user", "postgres"),
-			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.password", "password123"),
+			testCheckResourceAttrSecretHash("fivetran_destination.mydestination", "config.0.password", "password123"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.database", "fivetran"),
 			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.connection_type", "Directly"),
 		),
@@ -475,3 +477,155 @@ func TestResourceDestinationMock(t *testing.T) {
 		},
 	)
 }
+
+func setupMockClientDestinationImport(t *testing.T) {
+	setupMockClientDestinationConfigMapping(t)
+	testDestinationData = createMapFromJsonString(t, destinationMappingResponse)
+
+	destinationImportPatchHandler = mockClient.When(http.MethodPatch, "/v1/destinations/destination_id").ThenCall(
+		func(req *http.Request) (*http.Response, error) {
+			config := requestBodyToJson(t, req)["config"].(map[string]interface{})
+			// the secrets left out of the imported state and the salted hashes of the unchanged secrets are
+			// replaced with the configured values
+			assertKeyExistsAndHasValue(t, config, "password", "password")
+			assertKeyExistsAndHasValue(t, config, "passphrase", "passphrase")
+			testDestinationData["config"].(map[string]interface{})["host"] = config["host"]
+			return fivetranSuccessResponse(t, req, http.StatusOK, "Destination has been updated", testDestinationData), nil
+		},
+	)
+}
+
+func destinationImportConfig(host, secretsVersion string) string {
+	return `
+	import {
+		to = fivetran_destination.mydestination
+		id = "destination_id"
+	}
+
+	resource "fivetran_destination" "mydestination" {
+		provider = fivetran-provider
+
+		group_id = "group_id"
+		service = "snowflake"
+		time_zone_offset = "0"
+		region = "GCP_US_EAST4"
+		secrets_version = ` + secretsVersion + `
+
+		config {
+			host = "` + host + `"
+			port = "123"
+			database = "database"
+			auth = "auth"
+			user = "user"
+			password = "password"
+			connection_type = "connection_type"
+			tunnel_host = "tunnel_host"
+			tunnel_port = "123"
+			tunnel_user = "tunnel_user"
+			project_id = "project_id"
+			data_set_location = "data_set_location"
+			bucket = "bucket"
+			server_host_name = "server_host_name"
+			http_path = "http_path"
+			personal_access_token = "personal_access_token"
+			create_external_tables = "false"
+			external_location = "external_location"
+			auth_type = "auth_type"
+			role_arn = "role_arn"
+			secret_key = "secret_key"
+			private_key = "private_key"
+			cluster_id = "cluster_id"
+			cluster_region = "cluster_region"
+			role = "role"
+			is_private_key_encrypted = "false"
+			passphrase = "passphrase"
+			catalog = "catalog"
+		}
+	}`
+}
+
+func TestResourceDestinationImportMock(t *testing.T) {
+	step1 := resource.TestStep{
+		Config: destinationImportConfig("host", "1"),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// the imported state keeps the masked secrets, there is nothing to update after the import
+				assertEqual(t, destinationMappingPostHandler.Interactions, 0)
+				assertEqual(t, destinationImportPatchHandler.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.password", "******"),
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "run_setup_tests", "false"),
+		),
+	}
+
+	// the plan after the import is empty
+	stepPlan := resource.TestStep{
+		Config:   destinationImportConfig("host", "1"),
+		PlanOnly: true,
+	}
+
+	step2 := resource.TestStep{
+		Config: destinationImportConfig("new_host", "1"),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, destinationImportPatchHandler.Interactions, 1)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.host", "new_host"),
+			testCheckResourceAttrSecretHash("fivetran_destination.mydestination", "config.0.password", "password"),
+		),
+	}
+
+	// the unchanged secrets are sent again with the whole config block
+	step3 := resource.TestStep{
+		Config: destinationImportConfig("new_host_2", "1"),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, destinationImportPatchHandler.Interactions, 2)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "config.0.host", "new_host_2"),
+			testCheckResourceAttrSecretHash("fivetran_destination.mydestination", "config.0.password", "password"),
+		),
+	}
+
+	// the secrets rotated outside of Terraform are sent again after a secrets_version change
+	step4 := resource.TestStep{
+		Config: destinationImportConfig("new_host_2", "2"),
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				assertEqual(t, destinationImportPatchHandler.Interactions, 3)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_destination.mydestination", "secrets_version", "2"),
+			testCheckResourceAttrSecretHash("fivetran_destination.mydestination", "config.0.password", "password"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientDestinationImport(t)
+			},
+			Providers: testImportProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, destinationMappingDeleteHandler.Interactions, 1)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+				stepPlan,
+				step2,
+				step3,
+				step4,
+			},
+		},
+	)
+}
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"net/http"
+	"regexp"
 	"testing"
 	"time"
 
@@ -143,3 +144,40 @@ func TestResourceGroupMock(t *testing.T) {
 		},
 	)
 }
+
+func TestResourceGroupCreateTimeoutMock(t *testing.T) {
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientGroupResource(t)
+				groupPostHandler = mockClient.When(http.MethodPost, "/v1/groups").ThenCall(
+					func(req *http.Request) (*http.Response, error) {
+						// the request hangs until the create timeout
+						<-req.Context().Done()
+						return nil, req.Context().Err()
+					},
+				)
+			},
+			Providers: testProviders,
+
+			Steps: []resource.TestStep{
+				{
+					Config: `
+						resource "fivetran_group" "testgroup" {
+							provider = fivetran-provider
+							name = "test_group_name"
+
+							timeouts {
+								create = "100ms"
+							}
+						}`,
+					ExpectError: regexp.MustCompile(`create timeout(.|\n)*create\s+operation\s+didn't\s+complete\s+within\s+100ms`),
+				},
+			},
+		},
+	)
+
+	assertEqual(t, groupPostHandler.Interactions, 1)
+	assertEmpty(t, groupData)
+}
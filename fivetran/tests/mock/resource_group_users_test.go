@@ -239,3 +239,64 @@ func TestResourceGroupUsersMock(t *testing.T) {
 		},
 	)
 }
+
+func TestResourceGroupUsersImportMock(t *testing.T) {
+	initialUsers := []interface{}{
+		map[string]interface{}{"id": "john", "email": "john@mail.com", "role": "Destination Administrator"},
+		map[string]interface{}{"id": "jane", "email": "jane@mail.com", "role": "Destination Reviewer"},
+	}
+
+	step1 := resource.TestStep{
+		Config: `
+			import {
+				to = fivetran_group_users.test_group_users
+				id = "group_id"
+			}
+
+			resource "fivetran_group_users" "test_group_users" {
+				provider = fivetran-provider
+				group_id = "group_id"
+
+				user {
+					email = "john@mail.com"
+					role = "Destination Administrator"
+				}
+
+				user {
+					email = "jane@mail.com"
+					role = "Destination Reviewer"
+				}
+			}`,
+
+		Check: resource.ComposeAggregateTestCheckFunc(
+			func(s *terraform.State) error {
+				// the plan after the import is empty
+				assertEqual(t, groupGetHandler.Interactions, 1)
+				assertEqual(t, groupPostUserHandler.Interactions, 0)
+				assertEqual(t, groupDeleteUserHandler.Interactions, 0)
+				return nil
+			},
+			resource.TestCheckResourceAttr("fivetran_group_users.test_group_users", "id", "group_id"),
+			resource.TestCheckResourceAttr("fivetran_group_users.test_group_users", "group_id", "group_id"),
+			resource.TestCheckResourceAttr("fivetran_group_users.test_group_users", "user.#", "2"),
+		),
+	}
+
+	resource.Test(
+		t,
+		resource.TestCase{
+			PreCheck: func() {
+				setupMockClientGroupUsersResource(t, initialUsers)
+			},
+			Providers: testImportProviders,
+			CheckDestroy: func(s *terraform.State) error {
+				assertEqual(t, len(groupUsersData), 0)
+				return nil
+			},
+
+			Steps: []resource.TestStep{
+				step1,
+			},
+		},
+	)
+}
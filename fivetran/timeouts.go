@@ -0,0 +1,40 @@
+package fivetran
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// defaultResourceTimeout is the default of all operations timeouts, the same as the SDK default
+const defaultResourceTimeout = 20 * time.Minute
+
+// resourceTimeouts returns the create, read, update and delete timeouts, each of them can be set
+// in the resource timeouts block.
+func resourceTimeouts() *schema.ResourceTimeout {
+	return &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultResourceTimeout),
+		Read:   schema.DefaultTimeout(defaultResourceTimeout),
+		Update: schema.DefaultTimeout(defaultResourceTimeout),
+		Delete: schema.DefaultTimeout(defaultResourceTimeout),
+	}
+}
+
+// withTimeout wraps a CRUD function. The SDK passes the function a ctx with the deadline of the operation
+// timeout, so when the function fails after the deadline has passed, the failure is reported as a timeout
+// of the operation.
+func withTimeout(operation string, f func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := f(ctx, d, m)
+		if diags.HasError() && ctx.Err() == context.DeadlineExceeded {
+			timeoutDiag := newDiag(diag.Error, fmt.Sprintf("%v timeout", operation),
+				fmt.Sprintf("%v operation didn't complete within %v, the timeout can be increased with timeouts.%v",
+					operation, d.Timeout(operation), operation))
+			diags = append(diag.Diagnostics{timeoutDiag}, diags...)
+		}
+		return diags
+	}
+}
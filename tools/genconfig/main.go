@@ -0,0 +1,660 @@
+// Command genconfig generates the fivetran_connector config block schemas, the expand/flatten functions and
+// the catalog of the config fields of each service from a snapshot of the Fivetran connector config metadata.
+//
+// The snapshot lists the services with their config JSON schema, as returned by the connector metadata
+// endpoint. The config block is shared by all the services, so the generated schemas contain the union of
+// the service config properties:
+//   - a property is Optional when it is writable for at least one service and Computed when it has a
+//     default value or is read only for at least one service;
+//   - a property is Sensitive when its format is "password". The state keeps the salted hash of the top level
+//     sensitive strings that aren't computed, see secretDiffSuppressFunc;
+//   - an array with "uniqueItems" is a set in the resource schema, the data source uses lists only;
+//   - boolean and integer properties are TypeBool and TypeInt in the resource schema, "minimum" and "maximum"
+//     of an integer are validated. The data source keeps the scalar values as strings.
+//
+// The boolean and integer properties are sent only when they are set in the configuration, as their zero
+// values can't be told apart from the unset ones otherwise, so they are supported at the top level only.
+//
+// Usage:
+//
+//	go run ./tools/genconfig -metadata fivetran/metadata/connector_config.json -output fivetran/connector_config_gen.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// metadata is the snapshot of the connector config metadata
+type metadata struct {
+	Items []service `json:"items"`
+}
+
+type service struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Type   string   `json:"type"`
+	Config property `json:"config"`
+}
+
+// property is the subset of the JSON schema keywords used by the connector config metadata
+type property struct {
+	Type        string               `json:"type"`
+	Format      string               `json:"format"`
+	ReadOnly    bool                 `json:"readOnly"`
+	Default     interface{}          `json:"default"`
+	Enum        []interface{}        `json:"enum"`
+	UniqueItems bool                 `json:"uniqueItems"`
+	Items       *property            `json:"items"`
+	Properties  map[string]*property `json:"properties"`
+	Required    []string             `json:"required"`
+	Minimum     *int                 `json:"minimum"`
+	Maximum     *int                 `json:"maximum"`
+}
+
+const (
+	kindString  = "string"
+	kindInteger = "integer"
+	kindBoolean = "boolean"
+	kindList    = "list"    // array of strings
+	kindObjects = "objects" // array of objects
+)
+
+// field is a config property merged across the services
+type field struct {
+	Name      string
+	Kind      string
+	Sensitive bool
+	Optional  bool
+	Computed  bool
+	Required  bool
+	Set       bool
+	Minimum   *int
+	Maximum   *int
+	Fields    []*field
+}
+
+func (f *field) funcSuffix() string {
+	var result strings.Builder
+	for _, part := range strings.Split(f.Name, "_") {
+		if part != "" {
+			result.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return result.String()
+}
+
+// hashedSecret reports whether the state keeps the salted hash of the field value instead of the value, the
+// computed secrets are generated by Fivetran and are kept as they are
+func (f *field) hashedSecret(dataSource, topLevel bool) bool {
+	return !dataSource && topLevel && f.Sensitive && f.Kind == kindString && !f.Computed
+}
+
+func (f *field) hasSensitiveFields() bool {
+	for _, nested := range f.Fields {
+		if nested.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// stateKey returns the nested field the sensitive values of the f items are matched by in the state:
+// the only non-sensitive scalar field of the item.
+func (f *field) stateKey() (string, error) {
+	var keys []string
+	for _, nested := range f.Fields {
+		if !nested.Sensitive && nested.Kind != kindList {
+			keys = append(keys, nested.Name)
+		}
+	}
+	if len(keys) != 1 {
+		return "", fmt.Errorf("%v: the items with sensitive fields must have exactly one non-sensitive field to be matched by, got: %v", f.Name, keys)
+	}
+	return keys[0], nil
+}
+
+func main() {
+	metadataPath := flag.String("metadata", "", "path to the connector config metadata snapshot")
+	outputPath := flag.String("output", "", "path to the generated file")
+	packageName := flag.String("package", "fivetran", "package of the generated file")
+	skip := flag.String("skip", "schema,schema_prefix,table", "comma separated config properties managed outside of the config block")
+	flag.Parse()
+
+	if *metadataPath == "" || *outputPath == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	source, err := os.ReadFile(*metadataPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	result, err := generate(source, *packageName, filepath.ToSlash(*metadataPath), strings.Split(*skip, ","))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*outputPath, result, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted Go source generated from the metadata snapshot
+func generate(source []byte, packageName, metadataPath string, skip []string) ([]byte, error) {
+	var m metadata
+	if err := json.Unmarshal(source, &m); err != nil {
+		return nil, fmt.Errorf("metadata: %v", err)
+	}
+
+	fields, err := mergeServices(m.Items, skip)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{}
+	g.printf("// Code generated by genconfig from %v; DO NOT EDIT.\n\n", metadataPath)
+	g.printf("package %v\n\n", packageName)
+	g.imports(fields)
+	g.schemaFunc("resourceConnectorConfigSchema", "the fields of the fivetran_connector config block", fields, false)
+	g.schemaFunc("dataSourceConnectorConfigSchema", "the fields of the fivetran_connector data source config block", fields, true)
+	g.secretFields(fields)
+	g.expandFuncs(fields)
+	if err := g.resourceFlattenFuncs(fields); err != nil {
+		return nil, err
+	}
+	g.dataSourceFlattenFuncs(fields)
+	g.servicesCatalog(m.Items, skip)
+
+	result, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format: %v\n%s", err, g.buf.Bytes())
+	}
+	return result, nil
+}
+
+// mergeServices returns the union of the service config properties sorted by name
+func mergeServices(services []service, skip []string) ([]*field, error) {
+	skipped := make(map[string]bool)
+	for _, name := range skip {
+		skipped[strings.TrimSpace(name)] = true
+	}
+
+	byName := make(map[string]*field)
+	for _, s := range services {
+		if s.Config.Type != "object" {
+			return nil, fmt.Errorf("service %v: config type expected to be object, got: %q", s.ID, s.Config.Type)
+		}
+		for name, p := range s.Config.Properties {
+			if skipped[name] {
+				continue
+			}
+			f, ok := byName[name]
+			if !ok {
+				f = &field{Name: name}
+				byName[name] = f
+			}
+			if err := mergeProperty(f, p, false); err != nil {
+				return nil, fmt.Errorf("service %v: %v", s.ID, err)
+			}
+		}
+	}
+
+	return sortedFields(byName), nil
+}
+
+func mergeProperty(f *field, p *property, required bool) error {
+	kind, err := propertyKind(p)
+	if err != nil {
+		return fmt.Errorf("%v: %v", f.Name, err)
+	}
+	if f.Kind == "" {
+		f.Kind = kind
+		f.Set = p.UniqueItems
+		f.Required = required
+		f.Minimum = p.Minimum
+		f.Maximum = p.Maximum
+	} else {
+		if f.Kind != kind {
+			return fmt.Errorf("%v: conflicting types %v and %v", f.Name, f.Kind, kind)
+		}
+		if f.Set != p.UniqueItems {
+			return fmt.Errorf("%v: conflicting uniqueItems", f.Name)
+		}
+		f.Required = f.Required && required
+		if !equalIntP(f.Minimum, p.Minimum) || !equalIntP(f.Maximum, p.Maximum) {
+			return fmt.Errorf("%v: conflicting minimum/maximum", f.Name)
+		}
+	}
+
+	if p.Format == "password" || (p.Items != nil && p.Items.Format == "password") {
+		f.Sensitive = true
+	}
+	if p.ReadOnly {
+		f.Computed = true
+	} else {
+		f.Optional = true
+	}
+	if p.Default != nil {
+		f.Computed = true
+	}
+
+	if kind == kindObjects {
+		requiredNames := make(map[string]bool)
+		for _, name := range p.Items.Required {
+			requiredNames[name] = true
+		}
+		byName := make(map[string]*field)
+		for _, nested := range f.Fields {
+			byName[nested.Name] = nested
+		}
+		for name, nestedProperty := range p.Items.Properties {
+			nested, ok := byName[name]
+			if !ok {
+				nested = &field{Name: name}
+				byName[name] = nested
+			}
+			if err := mergeProperty(nested, nestedProperty, requiredNames[name]); err != nil {
+				return fmt.Errorf("%v.%v", f.Name, err)
+			}
+		}
+		f.Fields = sortedFields(byName)
+		for _, nested := range f.Fields {
+			if nested.Kind == kindBoolean || nested.Kind == kindInteger {
+				return fmt.Errorf("%v.%v: boolean and integer fields are supported at the top level only", f.Name, nested.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func equalIntP(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func propertyKind(p *property) (string, error) {
+	switch p.Type {
+	case "string":
+		return kindString, nil
+	case "integer":
+		return kindInteger, nil
+	case "boolean":
+		return kindBoolean, nil
+	case "array":
+		if p.Items == nil {
+			return "", fmt.Errorf("array without items")
+		}
+		switch p.Items.Type {
+		case "string":
+			return kindList, nil
+		case "object":
+			return kindObjects, nil
+		}
+		return "", fmt.Errorf("unsupported array items type %q", p.Items.Type)
+	}
+	return "", fmt.Errorf("unsupported type %q", p.Type)
+}
+
+func sortedFields(byName map[string]*field) []*field {
+	result := make([]*field, 0, len(byName))
+	for _, f := range byName {
+		result = append(result, f)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+type generator struct {
+	buf bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) imports(fields []*field) {
+	imports := []string{"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"}
+	var typed, validated bool
+	for _, f := range fields {
+		if f.Kind == kindBoolean || f.Kind == kindInteger {
+			typed = true
+		}
+		if f.Minimum != nil || f.Maximum != nil {
+			validated = true
+		}
+	}
+	if typed {
+		imports = append(imports, "github.com/hashicorp/go-cty/cty")
+	}
+	if validated {
+		imports = append(imports, "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation")
+	}
+	g.printf("import (\n")
+	for _, i := range imports {
+		g.printf("%q\n", i)
+	}
+	g.printf(")\n\n")
+}
+
+func (g *generator) schemaFunc(name, description string, fields []*field, dataSource bool) {
+	g.printf("// %v returns %v\n", name, description)
+	g.printf("func %v() map[string]*schema.Schema {\n", name)
+	g.printf("return map[string]*schema.Schema{\n")
+	for _, f := range fields {
+		g.schemaField(f, dataSource, true)
+	}
+	g.printf("}\n}\n\n")
+}
+
+func (g *generator) secretFields(fields []*field) {
+	g.printf("// resourceConnectorConfigSecretFields are the config block fields with the salted hash of the value in the state\n")
+	g.printf("var resourceConnectorConfigSecretFields = []string{\n")
+	for _, f := range fields {
+		if f.hashedSecret(false, true) {
+			g.printf("%q,\n", f.Name)
+		}
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) schemaField(f *field, dataSource, topLevel bool) {
+	var attrs []string
+	switch f.Kind {
+	case kindList, kindObjects:
+		if f.Set && !dataSource {
+			attrs = append(attrs, "Type: schema.TypeSet")
+		} else {
+			attrs = append(attrs, "Type: schema.TypeList")
+		}
+	case kindBoolean:
+		if dataSource {
+			attrs = append(attrs, "Type: schema.TypeString")
+		} else {
+			attrs = append(attrs, "Type: schema.TypeBool")
+		}
+	case kindInteger:
+		if dataSource {
+			attrs = append(attrs, "Type: schema.TypeString")
+		} else {
+			attrs = append(attrs, "Type: schema.TypeInt")
+		}
+	default:
+		attrs = append(attrs, "Type: schema.TypeString")
+	}
+
+	switch {
+	case dataSource:
+		attrs = append(attrs, "Computed: true")
+	case f.Required:
+		attrs = append(attrs, "Required: true")
+	default:
+		if f.Optional {
+			attrs = append(attrs, "Optional: true")
+		}
+		if f.Computed {
+			attrs = append(attrs, "Computed: true")
+		}
+	}
+	if f.Sensitive {
+		attrs = append(attrs, "Sensitive: true")
+	}
+	if f.hashedSecret(dataSource, topLevel) {
+		attrs = append(attrs, "DiffSuppressFunc: secretDiffSuppressFunc")
+	}
+	if !dataSource && f.Kind == kindInteger {
+		switch {
+		case f.Minimum != nil && f.Maximum != nil:
+			attrs = append(attrs, fmt.Sprintf("ValidateFunc: validation.IntBetween(%v, %v)", *f.Minimum, *f.Maximum))
+		case f.Minimum != nil:
+			attrs = append(attrs, fmt.Sprintf("ValidateFunc: validation.IntAtLeast(%v)", *f.Minimum))
+		case f.Maximum != nil:
+			attrs = append(attrs, fmt.Sprintf("ValidateFunc: validation.IntAtMost(%v)", *f.Maximum))
+		}
+	}
+
+	switch f.Kind {
+	case kindList:
+		attrs = append(attrs, "Elem: &schema.Schema{Type: schema.TypeString}")
+	case kindObjects:
+		g.printf("%q: {%v,\n", f.Name, strings.Join(attrs, ", "))
+		g.printf("Elem: &schema.Resource{\nSchema: map[string]*schema.Schema{\n")
+		for _, nested := range f.Fields {
+			g.schemaField(nested, dataSource, false)
+		}
+		g.printf("},\n},\n},\n")
+		return
+	}
+	g.printf("%q: {%v},\n", f.Name, strings.Join(attrs, ", "))
+}
+
+func (g *generator) expandFuncs(fields []*field) {
+	g.printf("// resourceConnectorExpandConfig returns the request config of the config block c, raw is the config block\n")
+	g.printf("// in the configuration\n")
+	g.printf("func resourceConnectorExpandConfig(c map[string]interface{}, raw cty.Value) map[string]interface{} {\n")
+	g.printf("result := make(map[string]interface{})\n")
+	g.expandFields(fields, "c", "result")
+	g.printf("return result\n}\n\n")
+
+	for _, f := range fields {
+		if f.Kind != kindObjects || !f.Optional {
+			continue
+		}
+		g.printf("func resourceConnectorExpandConfig%v(xi []interface{}) []interface{} {\n", f.funcSuffix())
+		g.printf("result := make([]interface{}, len(xi))\n")
+		g.printf("for i, v := range xi {\n")
+		g.printf("c := v.(map[string]interface{})\n")
+		g.printf("item := make(map[string]interface{})\n")
+		g.expandFields(f.Fields, "c", "item")
+		g.printf("result[i] = item\n}\n")
+		g.printf("return result\n}\n\n")
+	}
+}
+
+func (g *generator) expandFields(fields []*field, source, target string) {
+	for _, f := range fields {
+		if !f.Optional {
+			// read only fields aren't sent
+			continue
+		}
+		switch f.Kind {
+		case kindString:
+			g.printf("if v := %v[%q].(string); v != \"\" {\n%v[%q] = v\n}\n", source, f.Name, target, f.Name)
+		case kindInteger:
+			g.printf("if connectorConfigRawIsSet(raw, %q) {\n%v[%q] = %v[%q].(int)\n}\n", f.Name, target, f.Name, source, f.Name)
+		case kindBoolean:
+			g.printf("if connectorConfigRawIsSet(raw, %q) {\n%v[%q] = %v[%q].(bool)\n}\n", f.Name, target, f.Name, source, f.Name)
+		case kindList:
+			g.printf("if v := %v; len(v) > 0 {\n%v[%q] = xInterfaceStrXStr(v)\n}\n", listExpr(f, source), target, f.Name)
+		case kindObjects:
+			g.printf("if v := %v; len(v) > 0 {\n%v[%q] = resourceConnectorExpandConfig%v(v)\n}\n", listExpr(f, source), target, f.Name, f.funcSuffix())
+		}
+	}
+}
+
+func listExpr(f *field, source string) string {
+	if f.Set {
+		return fmt.Sprintf("%v[%q].(*schema.Set).List()", source, f.Name)
+	}
+	return fmt.Sprintf("%v[%q].([]interface{})", source, f.Name)
+}
+
+func (g *generator) resourceFlattenFuncs(fields []*field) error {
+	g.printf("// resourceConnectorFlattenConfig returns the config block of the upstream config, the sensitive fields\n")
+	g.printf("// are taken from the currentConfig as Fivetran returns them masked\n")
+	g.printf("func resourceConnectorFlattenConfig(upstream map[string]interface{}, currentConfig []interface{}) map[string]interface{} {\n")
+	g.printf("c := make(map[string]interface{})\n")
+	var sensitive []*field
+	for _, f := range fields {
+		if f.Sensitive {
+			sensitive = append(sensitive, f)
+			continue
+		}
+		switch f.Kind {
+		case kindObjects:
+			if f.hasSensitiveFields() {
+				g.printf("mapAddXInterface(c, %q, resourceConnectorFlattenConfig%v(upstream[%q], currentConfig))\n", f.Name, f.funcSuffix(), f.Name)
+			} else {
+				g.printf("mapAddXInterface(c, %q, resourceConnectorFlattenConfig%v(upstream[%q]))\n", f.Name, f.funcSuffix(), f.Name)
+			}
+		case kindBoolean:
+			g.printf("if v, ok := connectorConfigValueBool(upstream[%q]); ok {\nc[%q] = v\n}\n", f.Name, f.Name)
+		case kindInteger:
+			g.printf("if v, ok := connectorConfigValueInt(upstream[%q]); ok {\nc[%q] = v\n}\n", f.Name, f.Name)
+		default:
+			g.flattenField(f, "c", "upstream")
+		}
+	}
+	if len(sensitive) > 0 {
+		g.printf("if len(currentConfig) > 0 && currentConfig[0] != nil {\n")
+		g.printf("current := currentConfig[0].(map[string]interface{})\n")
+		for _, f := range sensitive {
+			g.stateField(f, "c", "current")
+		}
+		g.printf("}\n")
+	}
+	g.printf("return c\n}\n\n")
+
+	for _, f := range fields {
+		if f.Kind != kindObjects || f.Sensitive {
+			continue
+		}
+		if !f.hasSensitiveFields() {
+			g.printf("func resourceConnectorFlattenConfig%v(upstream interface{}) []interface{} {\n", f.funcSuffix())
+			g.flattenItems(f, nil)
+			continue
+		}
+		key, err := f.stateKey()
+		if err != nil {
+			return err
+		}
+		g.printf("func resourceConnectorFlattenConfig%v(upstream interface{}, currentConfig []interface{}) []interface{} {\n", f.funcSuffix())
+		g.flattenItems(f, func(nested *field) {
+			g.printf("if len(currentConfig) > 0 && currentConfig[0] != nil {\n")
+			g.printf("mapAddStr(item, %q, getSubcollectionElementStr(%q, %q, connectorConfigValueStr(u[%q]), %q, currentConfig))\n",
+				nested.Name, f.Name, key, key, nested.Name)
+			g.printf("} else {\n")
+			g.printf("// on import the masked values are the only ones available\n")
+			g.flattenField(nested, "item", "u")
+			g.printf("}\n")
+		})
+	}
+	return nil
+}
+
+// flattenItems prints the body of an objects field flatten function, the sensitive fields are printed by
+// the sensitiveField func when it is set.
+func (g *generator) flattenItems(f *field, sensitiveField func(*field)) {
+	g.printf("xi, _ := upstream.([]interface{})\n")
+	g.printf("result := make([]interface{}, 0, len(xi))\n")
+	g.printf("for _, v := range xi {\n")
+	g.printf("u, ok := v.(map[string]interface{})\n")
+	g.printf("if !ok {\ncontinue\n}\n")
+	g.printf("item := make(map[string]interface{})\n")
+	for _, nested := range f.Fields {
+		if nested.Sensitive && sensitiveField != nil {
+			sensitiveField(nested)
+			continue
+		}
+		g.flattenField(nested, "item", "u")
+	}
+	g.printf("result = append(result, item)\n}\n")
+	g.printf("return result\n}\n\n")
+}
+
+func (g *generator) flattenField(f *field, target, source string) {
+	switch f.Kind {
+	case kindList:
+		g.printf("mapAddXInterface(%v, %q, connectorConfigValueList(%v[%q]))\n", target, f.Name, source, f.Name)
+	default:
+		g.printf("mapAddStr(%v, %q, connectorConfigValueStr(%v[%q]))\n", target, f.Name, source, f.Name)
+	}
+}
+
+func (g *generator) stateField(f *field, target, source string) {
+	switch f.Kind {
+	case kindList:
+		g.printf("mapAddXInterface(%v, %q, %v)\n", target, f.Name, listExpr(f, source))
+	case kindObjects:
+		g.printf("mapAddXInterface(%v, %q, %v)\n", target, f.Name, listExpr(f, source))
+	case kindBoolean, kindInteger:
+		g.printf("%v[%q] = %v[%q]\n", target, f.Name, source, f.Name)
+	default:
+		g.printf("mapAddStr(%v, %q, %v[%q].(string))\n", target, f.Name, source, f.Name)
+	}
+}
+
+func (g *generator) dataSourceFlattenFuncs(fields []*field) {
+	g.printf("// dataSourceConnectorFlattenConfig returns the data source config block of the upstream config\n")
+	g.printf("func dataSourceConnectorFlattenConfig(upstream map[string]interface{}) map[string]interface{} {\n")
+	g.printf("c := make(map[string]interface{})\n")
+	for _, f := range fields {
+		if f.Kind == kindObjects {
+			g.printf("mapAddXInterface(c, %q, dataSourceConnectorFlattenConfig%v(upstream[%q]))\n", f.Name, f.funcSuffix(), f.Name)
+			continue
+		}
+		g.flattenField(f, "c", "upstream")
+	}
+	g.printf("return c\n}\n\n")
+
+	for _, f := range fields {
+		if f.Kind != kindObjects {
+			continue
+		}
+		g.printf("func dataSourceConnectorFlattenConfig%v(upstream interface{}) []interface{} {\n", f.funcSuffix())
+		g.flattenItems(f, nil)
+	}
+}
+
+// servicesCatalog prints the catalog of the config fields of each service, used to validate the config block
+// of the chosen service
+func (g *generator) servicesCatalog(services []service, skip []string) {
+	skipped := make(map[string]bool)
+	for _, name := range skip {
+		skipped[strings.TrimSpace(name)] = true
+	}
+
+	sorted := append([]service(nil), services...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	g.printf("// connectorConfigServices is the catalog of the config fields of each service\n")
+	g.printf("var connectorConfigServices = map[string]map[string]connectorConfigServiceField{\n")
+	for _, s := range sorted {
+		names := make([]string, 0, len(s.Config.Properties))
+		for name := range s.Config.Properties {
+			if !skipped[name] {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		g.printf("%q: {\n", s.ID)
+		for _, name := range names {
+			p := s.Config.Properties[name]
+			var attrs []string
+			if p.ReadOnly {
+				attrs = append(attrs, "ReadOnly: true")
+			}
+			if len(p.Enum) > 0 {
+				values := make([]string, len(p.Enum))
+				for i, v := range p.Enum {
+					values[i] = fmt.Sprintf("%q", fmt.Sprint(v))
+				}
+				attrs = append(attrs, fmt.Sprintf("Enum: []string{%v}", strings.Join(values, ", ")))
+			}
+			g.printf("%q: {%v},\n", name, strings.Join(attrs, ", "))
+		}
+		g.printf("},\n")
+	}
+	g.printf("}\n")
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+var defaultSkip = []string{"schema", "schema_prefix", "table"}
+
+func assertGolden(t *testing.T, actual []byte, goldenPath string) {
+	if *update {
+		if err := os.WriteFile(goldenPath, actual, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("generated code doesn't match %v, run `go test ./tools/genconfig -update` to update it, got:\n%s", goldenPath, actual)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	source, err := os.ReadFile("testdata/metadata.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := generate(source, "fivetran", "testdata/metadata.json", defaultSkip)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assertGolden(t, actual, "testdata/connector_config_gen.go.golden")
+}
+
+// TestGeneratedConfigUpToDate makes sure the provider config code is regenerated after a metadata snapshot update
+func TestGeneratedConfigUpToDate(t *testing.T) {
+	source, err := os.ReadFile("../../fivetran/metadata/connector_config.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := generate(source, "fivetran", "metadata/connector_config.json", defaultSkip)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile("../../fivetran/connector_config_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Error("fivetran/connector_config_gen.go is out of date, run `go generate ./fivetran`")
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	for name, tc := range map[string]struct {
+		metadata string
+		expected string
+	}{
+		"conflicting types": {
+			metadata: `{"items": [
+				{"id": "a", "config": {"type": "object", "properties": {"port": {"type": "integer"}}}},
+				{"id": "b", "config": {"type": "object", "properties": {"port": {"type": "string"}}}}
+			]}`,
+			expected: "service b: port: conflicting types integer and string",
+		},
+		"unsupported type": {
+			metadata: `{"items": [{"id": "a", "config": {"type": "object", "properties": {"ratio": {"type": "number"}}}}]}`,
+			expected: `service a: ratio: unsupported type "number"`,
+		},
+		"nested boolean": {
+			metadata: `{"items": [{"id": "a", "config": {"type": "object", "properties": {"reports": {"type": "array", "items": {"type": "object", "properties": {
+				"table": {"type": "string"}, "enabled": {"type": "boolean"}
+			}}}}}}]}`,
+			expected: "reports.enabled: boolean and integer fields are supported at the top level only",
+		},
+		"sensitive items without a key": {
+			metadata: `{"items": [{"id": "a", "config": {"type": "object", "properties": {"credentials": {"type": "array", "items": {"type": "object", "properties": {
+				"user": {"type": "string"}, "host": {"type": "string"}, "password": {"type": "string", "format": "password"}
+			}}}}}}]}`,
+			expected: "credentials: the items with sensitive fields must have exactly one non-sensitive field to be matched by, got: [host user]",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := generate([]byte(tc.metadata), "fivetran", "metadata.json", defaultSkip)
+			if err == nil || !strings.Contains(err.Error(), tc.expected) {
+				t.Errorf("expected error %q, got: %v", tc.expected, err)
+			}
+		})
+	}
+}